@@ -1,9 +1,14 @@
 package main
 
 import (
+	"os"
 	"zadanie-6105/internal/app"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		app.Migrate(os.Args[2:])
+		return
+	}
 	app.Run()
 }
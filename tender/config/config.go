@@ -1,6 +1,11 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
 	"github.com/gookit/slog"
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/joho/godotenv"
@@ -9,20 +14,61 @@ import (
 type (
 	Config struct {
 		HTTP
+		Storage
 		PG
+		Auth
+		Migrations
+		Scheduler
 	}
 	HTTP struct {
 		ServerAddress string `env:"SERVER_ADDRESS"`
 	}
+	Storage struct {
+		// Backend — хранилище данных: postgres или memory. В памяти данные живут до перезапуска
+		Backend string `env:"STORAGE" env-default:"postgres"`
+	}
 	PG struct {
 		PostgresConn string `env:"POSTGRES_CONN"`
 	}
+	Auth struct {
+		JWTKey   string        `env:"JWT_KEY"`
+		TokenTTL time.Duration `env:"JWT_TOKEN_TTL" env-default:"24h"`
+		// DevMode разрешает представляться параметром ?username= без токена. Только для разработки
+		DevMode bool `env:"AUTH_DEV_MODE" env-default:"false"`
+	}
+	Migrations struct {
+		// MigrateOnStart применяет недостающие миграции при запуске сервиса
+		MigrateOnStart bool `env:"MIGRATE_ON_START" env-default:"true"`
+	}
+	Scheduler struct {
+		// DeadlineCheckInterval — как часто закрываются тендеры с истекшим сроком подачи предложений
+		DeadlineCheckInterval time.Duration `env:"DEADLINE_CHECK_INTERVAL" env-default:"1m"`
+		// PublishCheckInterval — как часто публикуются тендеры, время отложенной публикации которых наступило
+		PublishCheckInterval time.Duration `env:"PUBLISH_CHECK_INTERVAL" env-default:"1m"`
+	}
+)
+
+const (
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
 )
 
+// Validate проверяет, что интервалы фоновых задач положительны: с нулевым интервалом расписание не запустить.
+func (s Scheduler) Validate() error {
+	if s.DeadlineCheckInterval <= 0 {
+		return fmt.Errorf("DEADLINE_CHECK_INTERVAL must be positive, got %s", s.DeadlineCheckInterval)
+	}
+	if s.PublishCheckInterval <= 0 {
+		return fmt.Errorf("PUBLISH_CHECK_INTERVAL must be positive, got %s", s.PublishCheckInterval)
+	}
+	return nil
+}
+
 func NewConfig() *Config {
 	cfg := &Config{}
+	// .env нужен только для локального запуска, в остальных окружениях переменные задаются снаружи
 	err := godotenv.Load()
-	if err != nil {
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
 		slog.Fatalf("can't load env %s", err.Error())
 	}
 	err = cleanenv.ReadEnv(cfg)
@@ -0,0 +1,2709 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v2"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for BidAuthorType.
+const (
+	BidAuthorTypeOrganization BidAuthorType = "Organization"
+	BidAuthorTypeUser         BidAuthorType = "User"
+)
+
+// Defines values for BidDecision.
+const (
+	Approved BidDecision = "Approved"
+	Rejected BidDecision = "Rejected"
+)
+
+// Defines values for BidStatus.
+const (
+	BidStatusCanceled  BidStatus = "Canceled"
+	BidStatusCreated   BidStatus = "Created"
+	BidStatusPublished BidStatus = "Published"
+)
+
+// Defines values for OrganizationPermissionsPermissions.
+const (
+	OrganizationPermissionsPermissionsBidManage          OrganizationPermissionsPermissions = "bid:manage"
+	OrganizationPermissionsPermissionsBidReview          OrganizationPermissionsPermissions = "bid:review"
+	OrganizationPermissionsPermissionsBidView            OrganizationPermissionsPermissions = "bid:view"
+	OrganizationPermissionsPermissionsOrganizationManage OrganizationPermissionsPermissions = "organization:manage"
+	OrganizationPermissionsPermissionsTenderManage       OrganizationPermissionsPermissions = "tender:manage"
+	OrganizationPermissionsPermissionsTenderView         OrganizationPermissionsPermissions = "tender:view"
+)
+
+// Defines values for OrganizationRole.
+const (
+	BidAuthor     OrganizationRole = "bid_author"
+	Owner         OrganizationRole = "owner"
+	Reviewer      OrganizationRole = "reviewer"
+	TenderManager OrganizationRole = "tender_manager"
+	Viewer        OrganizationRole = "viewer"
+)
+
+// Defines values for OrganizationType.
+const (
+	IE  OrganizationType = "IE"
+	JSC OrganizationType = "JSC"
+	LLC OrganizationType = "LLC"
+)
+
+// Defines values for TenderPublicationStatus.
+const (
+	TenderPublicationStatusCanceled TenderPublicationStatus = "Canceled"
+	TenderPublicationStatusDone     TenderPublicationStatus = "Done"
+	TenderPublicationStatusFailed   TenderPublicationStatus = "Failed"
+	TenderPublicationStatusPending  TenderPublicationStatus = "Pending"
+)
+
+// Defines values for TenderServiceType.
+const (
+	Construction TenderServiceType = "Construction"
+	Delivery     TenderServiceType = "Delivery"
+	Manufacture  TenderServiceType = "Manufacture"
+)
+
+// Defines values for TenderStatus.
+const (
+	Closed    TenderStatus = "Closed"
+	Created   TenderStatus = "Created"
+	Published TenderStatus = "Published"
+)
+
+// Bid Информация о предложении
+type Bid struct {
+	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+	AuthorId BidAuthorId `json:"authorId"`
+
+	// AuthorType Тип автора. Предложения подаются от имени организации, поэтому сервер возвращает Organization.
+	AuthorType BidAuthorType `json:"authorType"`
+
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил предложение на создание.
+	// Передается в формате RFC3339.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatorUsername Уникальный slug пользователя.
+	CreatorUsername Username `json:"creatorUsername"`
+
+	// Description Описание предложения
+	Description BidDescription `json:"description"`
+
+	// Id Уникальный идентификатор предложения, присвоенный сервером.
+	Id BidId `json:"id"`
+
+	// Name Полное название предложения
+	Name BidName `json:"name"`
+
+	// Status Статус предложения
+	Status BidStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// Version Номер версии посел правок
+	Version BidVersion `json:"version"`
+}
+
+// BidAuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+type BidAuthorId = openapi_types.UUID
+
+// BidAuthorType Тип автора. Предложения подаются от имени организации, поэтому сервер возвращает Organization.
+type BidAuthorType string
+
+// BidDecision Решение по предложению
+type BidDecision string
+
+// BidDecisionVote Голос ответственного по предложению
+type BidDecisionVote struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Decision Решение по предложению
+	Decision BidDecision        `json:"decision"`
+	Id       openapi_types.UUID `json:"id"`
+
+	// Username Уникальный slug пользователя.
+	Username Username `json:"username"`
+}
+
+// BidDescription Описание предложения
+type BidDescription = string
+
+// BidFeedback Отзыв на предложение
+type BidFeedback = string
+
+// BidId Уникальный идентификатор предложения, присвоенный сервером.
+type BidId = openapi_types.UUID
+
+// BidName Полное название предложения
+type BidName = string
+
+// BidPage Страница предложений, если запрошен `envelope=true`.
+type BidPage struct {
+	Items []Bid `json:"items"`
+
+	// NextCursor Курсор следующей страницы, как в заголовке `X-Next-Cursor`.
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// Total Общее число предложений по запросу, как в заголовке `X-Total-Count`.
+	Total *int32 `json:"total,omitempty"`
+}
+
+// BidReview Отзыв о предложении
+type BidReview struct {
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил отзыв на предложение.
+	// Передается в формате RFC3339.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Description Описание предложения
+	Description BidReviewDescription `json:"description"`
+
+	// Id Уникальный идентификатор отзыва, присвоенный сервером.
+	Id BidReviewId `json:"id"`
+}
+
+// BidReviewDescription Описание предложения
+type BidReviewDescription = string
+
+// BidReviewId Уникальный идентификатор отзыва, присвоенный сервером.
+type BidReviewId = openapi_types.UUID
+
+// BidStatus Статус предложения
+type BidStatus string
+
+// BidVersion Номер версии посел правок
+type BidVersion = int32
+
+// Employee Сотрудник
+type Employee struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	FirstName EmployeeName       `json:"firstName"`
+	Id        openapi_types.UUID `json:"id"`
+	LastName  EmployeeName       `json:"lastName"`
+
+	// Username Уникальный slug пользователя.
+	Username Username `json:"username"`
+}
+
+// EmployeeName defines model for employeeName.
+type EmployeeName = string
+
+// ErrorResponse Описание ошибки в формате RFC 7807 (`application/problem+json`). Клиентам следует опираться на `code`:
+// текст в `detail` и `reason` предназначен для человека и может меняться.
+type ErrorResponse struct {
+	// Code Стабильный машиночитаемый код ошибки, например `tender_not_found`, `access_denied`, `user_not_found`,
+	// `invalid_request` или `validation_failed`.
+	Code string `json:"code"`
+
+	// Detail Описание ошибки в свободной форме
+	Detail string `json:"detail"`
+
+	// Errors Нарушения ограничений спецификации по каждому полю. Заполняется, если запрос не прошел проверку.
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Reason Описание ошибки в свободной форме. Совпадает с `detail`, оставлено для совместимости.
+	Reason string `json:"reason"`
+
+	// Status HTTP-статус ответа
+	Status int `json:"status"`
+
+	// Title Краткое описание HTTP-статуса на языке ответа
+	Title string `json:"title"`
+
+	// Type Тип проблемы по RFC 7807
+	Type string `json:"type"`
+}
+
+// FieldError Нарушение ограничения в одном поле запроса
+type FieldError struct {
+	// Field Имя параметра или путь до поля тела запроса через точку
+	Field string `json:"field"`
+
+	// Message Описание нарушения
+	Message string `json:"message"`
+}
+
+// Organization Организация
+type Organization struct {
+	CreatedAt   time.Time `json:"createdAt"`
+	Description string    `json:"description"`
+
+	// Id Уникальный идентификатор организации, присвоенный сервером.
+	Id   OrganizationId `json:"id"`
+	Name string         `json:"name"`
+
+	// Type Организационно-правовая форма
+	Type OrganizationType `json:"type"`
+}
+
+// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+type OrganizationId = openapi_types.UUID
+
+// OrganizationParams defines model for organizationParams.
+type OrganizationParams struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+
+	// Type Организационно-правовая форма
+	Type *OrganizationType `json:"type,omitempty"`
+}
+
+// OrganizationPermissions Роль и права сотрудника в одной организации
+type OrganizationPermissions struct {
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId                       `json:"organizationId"`
+	Permissions    []OrganizationPermissionsPermissions `json:"permissions"`
+
+	// Role Роль сотрудника в организации
+	Role OrganizationRole `json:"role"`
+}
+
+// OrganizationPermissionsPermissions defines model for OrganizationPermissions.Permissions.
+type OrganizationPermissionsPermissions string
+
+// OrganizationRole Роль сотрудника в организации
+type OrganizationRole string
+
+// OrganizationType Организационно-правовая форма
+type OrganizationType string
+
+// Password Пароль сотрудника.
+type Password = string
+
+// PublishAt Время отложенной публикации (RFC3339). В тендере передается только в ответе на создание, дальше
+// публикацией управляет `/tenders/{tenderId}/publication`.
+type PublishAt = time.Time
+
+// Responsible defines model for responsible.
+type Responsible struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	FirstName EmployeeName       `json:"firstName"`
+	Id        openapi_types.UUID `json:"id"`
+	LastName  EmployeeName       `json:"lastName"`
+
+	// Role Роль сотрудника в организации
+	Role OrganizationRole `json:"role"`
+
+	// Username Уникальный slug пользователя.
+	Username Username `json:"username"`
+}
+
+// SubmissionDeadline Срок подачи предложений (RFC3339). После него новые предложения не принимаются, а опубликованный тендер
+// автоматически закрывается. При создании и правке должен быть в будущем.
+type SubmissionDeadline = time.Time
+
+// Tender Информация о тендере
+type Tender struct {
+	// ClosedBy Кто закрыл тендер: имя пользователя, `system`, если тендер закрыт автоматически по истечении
+	// срока подачи предложений, или `award`, если тендер закрыт согласованием предложения.
+	// Передается только у закрытых тендеров.
+	ClosedBy *string `json:"closedBy,omitempty"`
+
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил тендер на создание.
+	// Передается в формате RFC3339.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Id Уникальный идентификатор тендера, присвоенный сервером.
+	Id TenderId `json:"id"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// PublishAt Время отложенной публикации (RFC3339). В тендере передается только в ответе на создание, дальше
+	// публикацией управляет `/tenders/{tenderId}/publication`.
+	PublishAt *PublishAt `json:"publishAt,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+
+	// Status Статус тендер
+	Status TenderStatus `json:"status"`
+
+	// SubmissionDeadline Срок подачи предложений (RFC3339). После него новые предложения не принимаются, а опубликованный тендер
+	// автоматически закрывается. При создании и правке должен быть в будущем.
+	SubmissionDeadline *SubmissionDeadline `json:"submissionDeadline,omitempty"`
+
+	// Version Номер версии посел правок
+	Version TenderVersion `json:"version"`
+}
+
+// TenderDescription Описание тендера
+type TenderDescription = string
+
+// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+type TenderId = openapi_types.UUID
+
+// TenderName Полное название тендера
+type TenderName = string
+
+// TenderPage Страница тендеров, если запрошен `envelope=true`.
+type TenderPage struct {
+	Items []Tender `json:"items"`
+
+	// NextCursor Курсор следующей страницы, как в заголовке `X-Next-Cursor`.
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// Total Общее число тендеров по запросу, как в заголовке `X-Total-Count`.
+	Total *int32 `json:"total,omitempty"`
+}
+
+// TenderPublication Отложенная публикация тендера
+type TenderPublication struct {
+	// CreatedBy Пользователь, от имени которого будет опубликован тендер.
+	CreatedBy string `json:"createdBy"`
+
+	// Error Код ошибки, если публикация отклонена.
+	Error *string `json:"error,omitempty"`
+
+	// PublishAt Время отложенной публикации (RFC3339). В тендере передается только в ответе на создание, дальше
+	// публикацией управляет `/tenders/{tenderId}/publication`.
+	PublishAt PublishAt `json:"publishAt"`
+
+	// Status `Pending` — ожидает, `Done` — тендер опубликован, `Failed` — публикация отклонена, `Canceled` — отменена.
+	Status TenderPublicationStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// UpdatedAt Время последнего изменения.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TenderPublicationStatus `Pending` — ожидает, `Done` — тендер опубликован, `Failed` — публикация отклонена, `Canceled` — отменена.
+type TenderPublicationStatus string
+
+// TenderSearchResult Найденный тендер
+type TenderSearchResult struct {
+	// Rank Релевантность. Сравнивать имеет смысл только результаты одного запроса.
+	Rank float64 `json:"rank"`
+
+	// Snippet Фрагменты названия и описания с найденными словами, обернутыми в `<mark>`. Текст тендера экранирован
+	// как HTML, поэтому сниппет можно вставлять в страницу как есть.
+	Snippet string `json:"snippet"`
+
+	// Tender Информация о тендере
+	Tender Tender `json:"tender"`
+}
+
+// TenderServiceType Вид услуги, к которой относиться тендер
+type TenderServiceType string
+
+// TenderStatus Статус тендер
+type TenderStatus string
+
+// TenderVersion Номер версии посел правок
+type TenderVersion = int32
+
+// Token Токен доступа для заголовка `Authorization: Bearer <token>`.
+type Token struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+	Token     string    `json:"token"`
+}
+
+// Username Уникальный slug пользователя.
+type Username = string
+
+// EmployeeUsername Уникальный slug пользователя.
+type EmployeeUsername = Username
+
+// IfMatch defines model for ifMatch.
+type IfMatch = string
+
+// PaginationCursor defines model for paginationCursor.
+type PaginationCursor = string
+
+// PaginationEnvelope defines model for paginationEnvelope.
+type PaginationEnvelope = bool
+
+// PaginationLimit defines model for paginationLimit.
+type PaginationLimit = int32
+
+// PaginationOffset defines model for paginationOffset.
+type PaginationOffset = int32
+
+// PaginationTotal defines model for paginationTotal.
+type PaginationTotal = bool
+
+// BadRequest Описание ошибки в формате RFC 7807 (`application/problem+json`). Клиентам следует опираться на `code`:
+// текст в `detail` и `reason` предназначен для человека и может меняться.
+type BadRequest = ErrorResponse
+
+// Conflict Описание ошибки в формате RFC 7807 (`application/problem+json`). Клиентам следует опираться на `code`:
+// текст в `detail` и `reason` предназначен для человека и может меняться.
+type Conflict = ErrorResponse
+
+// Forbidden Описание ошибки в формате RFC 7807 (`application/problem+json`). Клиентам следует опираться на `code`:
+// текст в `detail` и `reason` предназначен для человека и может меняться.
+type Forbidden = ErrorResponse
+
+// NotFound Описание ошибки в формате RFC 7807 (`application/problem+json`). Клиентам следует опираться на `code`:
+// текст в `detail` и `reason` предназначен для человека и может меняться.
+type NotFound = ErrorResponse
+
+// Unauthorized Описание ошибки в формате RFC 7807 (`application/problem+json`). Клиентам следует опираться на `code`:
+// текст в `detail` и `reason` предназначен для человека и может меняться.
+type Unauthorized = ErrorResponse
+
+// LoginJSONBody defines parameters for Login.
+type LoginJSONBody struct {
+	// Password Пароль сотрудника.
+	Password Password `json:"password"`
+
+	// Username Уникальный slug пользователя.
+	Username Username `json:"username"`
+}
+
+// GetUserBidsParams defines parameters for GetUserBids.
+type GetUserBidsParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	//
+	// Оставлен для совместимости: если новые объекты появляются между запросами, страницы сдвигаются.
+	// Вместе с `cursor` не учитывается.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Значение заголовка `X-Next-Cursor` или поля `nextCursor` из ответа с предыдущей страницей. Следующая
+	// страница начинается сразу после последнего объекта предыдущей, поэтому новые объекты не сдвигают страницы.
+	Cursor *PaginationCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Total Посчитать общее число объектов и вернуть его в заголовке `X-Total-Count`, а с `envelope=true` еще и
+	// в поле `total`.
+	Total *PaginationTotal `form:"total,omitempty" json:"total,omitempty"`
+
+	// Envelope Вернуть страницу объектом с полями `items`, `nextCursor` и `total` вместо массива. Курсор и общее
+	// число объектов по-прежнему передаются и в заголовках. Без параметра тело остается массивом,
+	// чтобы не ломать клиентов, которые пагинацию не используют.
+	Envelope *PaginationEnvelope `form:"envelope,omitempty" json:"envelope,omitempty"`
+}
+
+// CreateBidJSONBody defines parameters for CreateBid.
+type CreateBidJSONBody struct {
+	// Description Описание предложения
+	Description BidDescription `json:"description"`
+
+	// Name Полное название предложения
+	Name BidName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// EditBidJSONBody defines parameters for EditBid.
+type EditBidJSONBody struct {
+	// Description Описание предложения
+	Description *BidDescription `json:"description,omitempty"`
+
+	// Name Полное название предложения
+	Name *BidName `json:"name,omitempty"`
+}
+
+// EditBidParams defines parameters for EditBid.
+type EditBidParams struct {
+	// IfMatch ETag версии, которую видел клиент. Если объект с тех пор изменился, запрос отклоняется с кодом 412.
+	// ETag сравнивается строго, слабые значения вида `W/"3"` тоже отклоняются с кодом 412.
+	//
+	// Без заголовка или со значением `*` версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SubmitBidFeedbackParams defines parameters for SubmitBidFeedback.
+type SubmitBidFeedbackParams struct {
+	BidFeedback BidFeedback `form:"bidFeedback" json:"bidFeedback"`
+}
+
+// RollbackBidParams defines parameters for RollbackBid.
+type RollbackBidParams struct {
+	// IfMatch ETag версии, которую видел клиент. Если объект с тех пор изменился, запрос отклоняется с кодом 412.
+	// ETag сравнивается строго, слабые значения вида `W/"3"` тоже отклоняются с кодом 412.
+	//
+	// Без заголовка или со значением `*` версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdateBidStatusParams defines parameters for UpdateBidStatus.
+type UpdateBidStatusParams struct {
+	Status BidStatus `form:"status" json:"status"`
+
+	// IfMatch ETag версии, которую видел клиент. Если объект с тех пор изменился, запрос отклоняется с кодом 412.
+	// ETag сравнивается строго, слабые значения вида `W/"3"` тоже отклоняются с кодом 412.
+	//
+	// Без заголовка или со значением `*` версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SubmitBidDecisionParams defines parameters for SubmitBidDecision.
+type SubmitBidDecisionParams struct {
+	Decision BidDecision `form:"decision" json:"decision"`
+}
+
+// GetBidVersionsParams defines parameters for GetBidVersions.
+type GetBidVersionsParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	//
+	// Оставлен для совместимости: если новые объекты появляются между запросами, страницы сдвигаются.
+	// Вместе с `cursor` не учитывается.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetBidsForTenderParams defines parameters for GetBidsForTender.
+type GetBidsForTenderParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	//
+	// Оставлен для совместимости: если новые объекты появляются между запросами, страницы сдвигаются.
+	// Вместе с `cursor` не учитывается.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Значение заголовка `X-Next-Cursor` или поля `nextCursor` из ответа с предыдущей страницей. Следующая
+	// страница начинается сразу после последнего объекта предыдущей, поэтому новые объекты не сдвигают страницы.
+	Cursor *PaginationCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Total Посчитать общее число объектов и вернуть его в заголовке `X-Total-Count`, а с `envelope=true` еще и
+	// в поле `total`.
+	Total *PaginationTotal `form:"total,omitempty" json:"total,omitempty"`
+
+	// Envelope Вернуть страницу объектом с полями `items`, `nextCursor` и `total` вместо массива. Курсор и общее
+	// число объектов по-прежнему передаются и в заголовках. Без параметра тело остается массивом,
+	// чтобы не ломать клиентов, которые пагинацию не используют.
+	Envelope *PaginationEnvelope `form:"envelope,omitempty" json:"envelope,omitempty"`
+}
+
+// GetBidReviewsParams defines parameters for GetBidReviews.
+type GetBidReviewsParams struct {
+	// AuthorUsername Имя пользователя автора предложений, отзывы на которые нужно просмотреть.
+	AuthorUsername Username `form:"authorUsername" json:"authorUsername"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	//
+	// Оставлен для совместимости: если новые объекты появляются между запросами, страницы сдвигаются.
+	// Вместе с `cursor` не учитывается.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetEmployeesParams defines parameters for GetEmployees.
+type GetEmployeesParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	//
+	// Оставлен для совместимости: если новые объекты появляются между запросами, страницы сдвигаются.
+	// Вместе с `cursor` не учитывается.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreateEmployeeJSONBody defines parameters for CreateEmployee.
+type CreateEmployeeJSONBody struct {
+	FirstName *EmployeeName `json:"firstName,omitempty"`
+	LastName  *EmployeeName `json:"lastName,omitempty"`
+
+	// Password Пароль сотрудника.
+	Password Password `json:"password"`
+
+	// Username Уникальный slug пользователя.
+	Username Username `json:"username"`
+}
+
+// EditEmployeeJSONBody defines parameters for EditEmployee.
+type EditEmployeeJSONBody struct {
+	FirstName *EmployeeName `json:"firstName,omitempty"`
+	LastName  *EmployeeName `json:"lastName,omitempty"`
+}
+
+// GetOrganizationsParams defines parameters for GetOrganizations.
+type GetOrganizationsParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	//
+	// Оставлен для совместимости: если новые объекты появляются между запросами, страницы сдвигаются.
+	// Вместе с `cursor` не учитывается.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetResponsiblesParams defines parameters for GetResponsibles.
+type GetResponsiblesParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	//
+	// Оставлен для совместимости: если новые объекты появляются между запросами, страницы сдвигаются.
+	// Вместе с `cursor` не учитывается.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AddResponsibleParams defines parameters for AddResponsible.
+type AddResponsibleParams struct {
+	Role *OrganizationRole `form:"role,omitempty" json:"role,omitempty"`
+}
+
+// UpdateResponsibleRoleParams defines parameters for UpdateResponsibleRole.
+type UpdateResponsibleRoleParams struct {
+	Role OrganizationRole `form:"role" json:"role"`
+}
+
+// GetTendersParams defines parameters for GetTenders.
+type GetTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	//
+	// Оставлен для совместимости: если новые объекты появляются между запросами, страницы сдвигаются.
+	// Вместе с `cursor` не учитывается.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Значение заголовка `X-Next-Cursor` или поля `nextCursor` из ответа с предыдущей страницей. Следующая
+	// страница начинается сразу после последнего объекта предыдущей, поэтому новые объекты не сдвигают страницы.
+	Cursor *PaginationCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Total Посчитать общее число объектов и вернуть его в заголовке `X-Total-Count`, а с `envelope=true` еще и
+	// в поле `total`.
+	Total *PaginationTotal `form:"total,omitempty" json:"total,omitempty"`
+
+	// Envelope Вернуть страницу объектом с полями `items`, `nextCursor` и `total` вместо массива. Курсор и общее
+	// число объектов по-прежнему передаются и в заголовках. Без параметра тело остается массивом,
+	// чтобы не ломать клиентов, которые пагинацию не используют.
+	Envelope *PaginationEnvelope `form:"envelope,omitempty" json:"envelope,omitempty"`
+
+	// ServiceType Возвращенные тендеры должны соответствовать указанным видам услуг.
+	//
+	// Если список пустой, фильтры не применяются.
+	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// Status Возвращенные тендеры должны быть в одном из указанных статусов.
+	Status *[]TenderStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// OrganizationId Только тендеры указанной организации.
+	OrganizationId *OrganizationId `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+
+	// CreatedFrom Только тендеры, созданные в этот момент или позже (RFC3339).
+	CreatedFrom *time.Time `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
+
+	// CreatedTo Только тендеры, созданные раньше этого момента (RFC3339).
+	CreatedTo *time.Time `form:"createdTo,omitempty" json:"createdTo,omitempty"`
+
+	// Version Только тендеры с указанной текущей версией.
+	Version *TenderVersion `form:"version,omitempty" json:"version,omitempty"`
+
+	// Creator Только тендеры, созданные указанным пользователем.
+	Creator *Username `form:"creator,omitempty" json:"creator,omitempty"`
+
+	// Sort Поля сортировки через запятую, `-` перед полем — по убыванию: `sort=-createdAt,name`.
+	// Доступны `name`, `createdAt`, `version`, `status` и `serviceType`. При равенстве и без параметра
+	// тендеры идут от новых к старым.
+	//
+	// С сортировкой страницы листаются через `offset`: курсор описывает позицию только в порядке
+	// по умолчанию, поэтому `X-Next-Cursor` не возвращается, а `cursor` вместе с `sort` отклоняется.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetUserTendersParams defines parameters for GetUserTenders.
+type GetUserTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	//
+	// Оставлен для совместимости: если новые объекты появляются между запросами, страницы сдвигаются.
+	// Вместе с `cursor` не учитывается.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Значение заголовка `X-Next-Cursor` или поля `nextCursor` из ответа с предыдущей страницей. Следующая
+	// страница начинается сразу после последнего объекта предыдущей, поэтому новые объекты не сдвигают страницы.
+	Cursor *PaginationCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Total Посчитать общее число объектов и вернуть его в заголовке `X-Total-Count`, а с `envelope=true` еще и
+	// в поле `total`.
+	Total *PaginationTotal `form:"total,omitempty" json:"total,omitempty"`
+
+	// Envelope Вернуть страницу объектом с полями `items`, `nextCursor` и `total` вместо массива. Курсор и общее
+	// число объектов по-прежнему передаются и в заголовках. Без параметра тело остается массивом,
+	// чтобы не ломать клиентов, которые пагинацию не используют.
+	Envelope *PaginationEnvelope `form:"envelope,omitempty" json:"envelope,omitempty"`
+}
+
+// CreateTenderJSONBody defines parameters for CreateTender.
+type CreateTenderJSONBody struct {
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// PublishAt Время отложенной публикации (RFC3339). В тендере передается только в ответе на создание, дальше
+	// публикацией управляет `/tenders/{tenderId}/publication`.
+	PublishAt *PublishAt `json:"publishAt,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+
+	// SubmissionDeadline Срок подачи предложений (RFC3339). После него новые предложения не принимаются, а опубликованный тендер
+	// автоматически закрывается. При создании и правке должен быть в будущем.
+	SubmissionDeadline *SubmissionDeadline `json:"submissionDeadline,omitempty"`
+}
+
+// SearchTendersParams defines parameters for SearchTenders.
+type SearchTendersParams struct {
+	// Q Поисковый запрос.
+	Q string `form:"q" json:"q"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	//
+	// Оставлен для совместимости: если новые объекты появляются между запросами, страницы сдвигаются.
+	// Вместе с `cursor` не учитывается.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ServiceType Найденные тендеры должны соответствовать указанным видам услуг.
+	//
+	// Если список пустой, фильтры не применяются.
+	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+}
+
+// EditTenderJSONBody defines parameters for EditTender.
+type EditTenderJSONBody struct {
+	// Description Описание тендера
+	Description *TenderDescription `json:"description,omitempty"`
+
+	// Name Полное название тендера
+	Name *TenderName `json:"name,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType *TenderServiceType `json:"serviceType,omitempty"`
+
+	// SubmissionDeadline Срок подачи предложений (RFC3339). После него новые предложения не принимаются, а опубликованный тендер
+	// автоматически закрывается. При создании и правке должен быть в будущем.
+	SubmissionDeadline *SubmissionDeadline `json:"submissionDeadline,omitempty"`
+}
+
+// EditTenderParams defines parameters for EditTender.
+type EditTenderParams struct {
+	// IfMatch ETag версии, которую видел клиент. Если объект с тех пор изменился, запрос отклоняется с кодом 412.
+	// ETag сравнивается строго, слабые значения вида `W/"3"` тоже отклоняются с кодом 412.
+	//
+	// Без заголовка или со значением `*` версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ScheduleTenderPublicationJSONBody defines parameters for ScheduleTenderPublication.
+type ScheduleTenderPublicationJSONBody struct {
+	// PublishAt Время отложенной публикации (RFC3339). В тендере передается только в ответе на создание, дальше
+	// публикацией управляет `/tenders/{tenderId}/publication`.
+	PublishAt PublishAt `json:"publishAt"`
+}
+
+// RollbackTenderParams defines parameters for RollbackTender.
+type RollbackTenderParams struct {
+	// IfMatch ETag версии, которую видел клиент. Если объект с тех пор изменился, запрос отклоняется с кодом 412.
+	// ETag сравнивается строго, слабые значения вида `W/"3"` тоже отклоняются с кодом 412.
+	//
+	// Без заголовка или со значением `*` версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdateTenderStatusParams defines parameters for UpdateTenderStatus.
+type UpdateTenderStatusParams struct {
+	Status TenderStatus `form:"status" json:"status"`
+
+	// IfMatch ETag версии, которую видел клиент. Если объект с тех пор изменился, запрос отклоняется с кодом 412.
+	// ETag сравнивается строго, слабые значения вида `W/"3"` тоже отклоняются с кодом 412.
+	//
+	// Без заголовка или со значением `*` версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody LoginJSONBody
+
+// CreateBidJSONRequestBody defines body for CreateBid for application/json ContentType.
+type CreateBidJSONRequestBody CreateBidJSONBody
+
+// EditBidJSONRequestBody defines body for EditBid for application/json ContentType.
+type EditBidJSONRequestBody EditBidJSONBody
+
+// CreateEmployeeJSONRequestBody defines body for CreateEmployee for application/json ContentType.
+type CreateEmployeeJSONRequestBody CreateEmployeeJSONBody
+
+// EditEmployeeJSONRequestBody defines body for EditEmployee for application/json ContentType.
+type EditEmployeeJSONRequestBody EditEmployeeJSONBody
+
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody = OrganizationParams
+
+// EditOrganizationJSONRequestBody defines body for EditOrganization for application/json ContentType.
+type EditOrganizationJSONRequestBody = OrganizationParams
+
+// CreateTenderJSONRequestBody defines body for CreateTender for application/json ContentType.
+type CreateTenderJSONRequestBody CreateTenderJSONBody
+
+// EditTenderJSONRequestBody defines body for EditTender for application/json ContentType.
+type EditTenderJSONRequestBody EditTenderJSONBody
+
+// ScheduleTenderPublicationJSONRequestBody defines body for ScheduleTenderPublication for application/json ContentType.
+type ScheduleTenderPublicationJSONRequestBody ScheduleTenderPublicationJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Получение токена доступа
+	// (POST /auth/login)
+	Login(c *fiber.Ctx) error
+	// Получение списка ваших предложений
+	// (GET /bids/my)
+	GetUserBids(c *fiber.Ctx, params GetUserBidsParams) error
+	// Создание нового предложения
+	// (POST /bids/new)
+	CreateBid(c *fiber.Ctx) error
+	// Голоса по предложению
+	// (GET /bids/{bidId}/decisions)
+	GetBidDecisions(c *fiber.Ctx, bidId BidId) error
+	// Редактирование параметров предложения
+	// (PATCH /bids/{bidId}/edit)
+	EditBid(c *fiber.Ctx, bidId BidId, params EditBidParams) error
+	// Отправка отзыва по предложению
+	// (PUT /bids/{bidId}/feedback)
+	SubmitBidFeedback(c *fiber.Ctx, bidId BidId, params SubmitBidFeedbackParams) error
+	// Откат версии предложения
+	// (PUT /bids/{bidId}/rollback/{version})
+	RollbackBid(c *fiber.Ctx, bidId BidId, version int32, params RollbackBidParams) error
+	// Получение текущего статуса предложения
+	// (GET /bids/{bidId}/status)
+	GetBidStatus(c *fiber.Ctx, bidId BidId) error
+	// Изменение статуса предложения
+	// (PUT /bids/{bidId}/status)
+	UpdateBidStatus(c *fiber.Ctx, bidId BidId, params UpdateBidStatusParams) error
+	// Отправка решения по предложению
+	// (PUT /bids/{bidId}/submit_decision)
+	SubmitBidDecision(c *fiber.Ctx, bidId BidId, params SubmitBidDecisionParams) error
+	// История версий предложения
+	// (GET /bids/{bidId}/versions)
+	GetBidVersions(c *fiber.Ctx, bidId BidId, params GetBidVersionsParams) error
+	// Версия предложения
+	// (GET /bids/{bidId}/versions/{version})
+	GetBidVersion(c *fiber.Ctx, bidId BidId, version int32) error
+	// Получение списка предложений для тендера
+	// (GET /bids/{tenderId}/list)
+	GetBidsForTender(c *fiber.Ctx, tenderId TenderId, params GetBidsForTenderParams) error
+	// Просмотр отзывов на прошлые предложения
+	// (GET /bids/{tenderId}/reviews)
+	GetBidReviews(c *fiber.Ctx, tenderId TenderId, params GetBidReviewsParams) error
+	// Список сотрудников
+	// (GET /employees)
+	GetEmployees(c *fiber.Ctx, params GetEmployeesParams) error
+	// Регистрация сотрудника
+	// (POST /employees/new)
+	CreateEmployee(c *fiber.Ctx) error
+	// Удаление сотрудника
+	// (DELETE /employees/{employeeUsername})
+	DeleteEmployee(c *fiber.Ctx, employeeUsername EmployeeUsername) error
+	// Сотрудник
+	// (GET /employees/{employeeUsername})
+	GetEmployee(c *fiber.Ctx, employeeUsername EmployeeUsername) error
+	// Редактирование сотрудника
+	// (PATCH /employees/{employeeUsername}/edit)
+	EditEmployee(c *fiber.Ctx, employeeUsername EmployeeUsername) error
+	// Права сотрудника
+	// (GET /employees/{employeeUsername}/permissions)
+	GetEmployeePermissions(c *fiber.Ctx, employeeUsername EmployeeUsername) error
+	// Список организаций
+	// (GET /organizations)
+	GetOrganizations(c *fiber.Ctx, params GetOrganizationsParams) error
+	// Создание организации
+	// (POST /organizations/new)
+	CreateOrganization(c *fiber.Ctx) error
+	// Удаление организации
+	// (DELETE /organizations/{organizationId})
+	DeleteOrganization(c *fiber.Ctx, organizationId OrganizationId) error
+	// Организация
+	// (GET /organizations/{organizationId})
+	GetOrganization(c *fiber.Ctx, organizationId OrganizationId) error
+	// Редактирование организации
+	// (PATCH /organizations/{organizationId}/edit)
+	EditOrganization(c *fiber.Ctx, organizationId OrganizationId) error
+	// Ответственные организации
+	// (GET /organizations/{organizationId}/responsibles)
+	GetResponsibles(c *fiber.Ctx, organizationId OrganizationId, params GetResponsiblesParams) error
+	// Исключение ответственного
+	// (DELETE /organizations/{organizationId}/responsibles/{employeeUsername})
+	RemoveResponsible(c *fiber.Ctx, organizationId OrganizationId, employeeUsername EmployeeUsername) error
+	// Добавление ответственного
+	// (POST /organizations/{organizationId}/responsibles/{employeeUsername})
+	AddResponsible(c *fiber.Ctx, organizationId OrganizationId, employeeUsername EmployeeUsername, params AddResponsibleParams) error
+	// Смена роли ответственного
+	// (PUT /organizations/{organizationId}/responsibles/{employeeUsername})
+	UpdateResponsibleRole(c *fiber.Ctx, organizationId OrganizationId, employeeUsername EmployeeUsername, params UpdateResponsibleRoleParams) error
+	// Проверка доступности сервера
+	// (GET /ping)
+	CheckServer(c *fiber.Ctx) error
+	// Получение списка тендеров
+	// (GET /tenders)
+	GetTenders(c *fiber.Ctx, params GetTendersParams) error
+	// Получить тендеры пользователя
+	// (GET /tenders/my)
+	GetUserTenders(c *fiber.Ctx, params GetUserTendersParams) error
+	// Создание нового тендера
+	// (POST /tenders/new)
+	CreateTender(c *fiber.Ctx) error
+	// Полнотекстовый поиск тендеров
+	// (GET /tenders/search)
+	SearchTenders(c *fiber.Ctx, params SearchTendersParams) error
+	// Редактирование тендера
+	// (PATCH /tenders/{tenderId}/edit)
+	EditTender(c *fiber.Ctx, tenderId TenderId, params EditTenderParams) error
+	// Отмена публикации тендера
+	// (DELETE /tenders/{tenderId}/publication)
+	CancelTenderPublication(c *fiber.Ctx, tenderId TenderId) error
+	// Отложенная публикация тендера
+	// (GET /tenders/{tenderId}/publication)
+	GetTenderPublication(c *fiber.Ctx, tenderId TenderId) error
+	// Планирование публикации тендера
+	// (PUT /tenders/{tenderId}/publication)
+	ScheduleTenderPublication(c *fiber.Ctx, tenderId TenderId) error
+	// Откат версии тендера
+	// (PUT /tenders/{tenderId}/rollback/{version})
+	RollbackTender(c *fiber.Ctx, tenderId TenderId, version int32, params RollbackTenderParams) error
+	// Получение текущего статуса тендера
+	// (GET /tenders/{tenderId}/status)
+	GetTenderStatus(c *fiber.Ctx, tenderId TenderId) error
+	// Изменение статуса тендера
+	// (PUT /tenders/{tenderId}/status)
+	UpdateTenderStatus(c *fiber.Ctx, tenderId TenderId, params UpdateTenderStatusParams) error
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+type MiddlewareFunc fiber.Handler
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(c *fiber.Ctx) error {
+
+	return siw.Handler.Login(c)
+}
+
+// GetUserBids operation middleware
+func (siw *ServerInterfaceWrapper) GetUserBids(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserBidsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "total", query, &params.Total)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter total: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "envelope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "envelope", query, &params.Envelope)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter envelope: %w", err).Error())
+	}
+
+	return siw.Handler.GetUserBids(c, params)
+}
+
+// CreateBid operation middleware
+func (siw *ServerInterfaceWrapper) CreateBid(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreateBid(c)
+}
+
+// GetBidDecisions operation middleware
+func (siw *ServerInterfaceWrapper) GetBidDecisions(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", c.Params("bidId"), &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bidId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetBidDecisions(c, bidId)
+}
+
+// EditBid operation middleware
+func (siw *ServerInterfaceWrapper) EditBid(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", c.Params("bidId"), &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bidId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditBidParams
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if value := c.Get("If-Match"); value != "" {
+		var IfMatch IfMatch
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value, &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	return siw.Handler.EditBid(c, bidId, params)
+}
+
+// SubmitBidFeedback operation middleware
+func (siw *ServerInterfaceWrapper) SubmitBidFeedback(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", c.Params("bidId"), &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bidId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitBidFeedbackParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "bidFeedback" -------------
+
+	if paramValue := c.Query("bidFeedback"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument bidFeedback is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "bidFeedback", query, &params.BidFeedback)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bidFeedback: %w", err).Error())
+	}
+
+	return siw.Handler.SubmitBidFeedback(c, bidId, params)
+}
+
+// RollbackBid operation middleware
+func (siw *ServerInterfaceWrapper) RollbackBid(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", c.Params("bidId"), &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bidId: %w", err).Error())
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", c.Params("version"), &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter version: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackBidParams
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if value := c.Get("If-Match"); value != "" {
+		var IfMatch IfMatch
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value, &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	return siw.Handler.RollbackBid(c, bidId, version, params)
+}
+
+// GetBidStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetBidStatus(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", c.Params("bidId"), &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bidId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetBidStatus(c, bidId)
+}
+
+// UpdateBidStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBidStatus(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", c.Params("bidId"), &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bidId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateBidStatusParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "status" -------------
+
+	if paramValue := c.Query("status"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument status is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if value := c.Get("If-Match"); value != "" {
+		var IfMatch IfMatch
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value, &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	return siw.Handler.UpdateBidStatus(c, bidId, params)
+}
+
+// SubmitBidDecision operation middleware
+func (siw *ServerInterfaceWrapper) SubmitBidDecision(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", c.Params("bidId"), &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bidId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitBidDecisionParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "decision" -------------
+
+	if paramValue := c.Query("decision"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument decision is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "decision", query, &params.Decision)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter decision: %w", err).Error())
+	}
+
+	return siw.Handler.SubmitBidDecision(c, bidId, params)
+}
+
+// GetBidVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetBidVersions(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", c.Params("bidId"), &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bidId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidVersionsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	return siw.Handler.GetBidVersions(c, bidId, params)
+}
+
+// GetBidVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetBidVersion(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", c.Params("bidId"), &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bidId: %w", err).Error())
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", c.Params("version"), &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter version: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetBidVersion(c, bidId, version)
+}
+
+// GetBidsForTender operation middleware
+func (siw *ServerInterfaceWrapper) GetBidsForTender(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", c.Params("tenderId"), &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tenderId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidsForTenderParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "total", query, &params.Total)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter total: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "envelope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "envelope", query, &params.Envelope)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter envelope: %w", err).Error())
+	}
+
+	return siw.Handler.GetBidsForTender(c, tenderId, params)
+}
+
+// GetBidReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetBidReviews(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", c.Params("tenderId"), &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tenderId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidReviewsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "authorUsername" -------------
+
+	if paramValue := c.Query("authorUsername"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument authorUsername is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "authorUsername", query, &params.AuthorUsername)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter authorUsername: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	return siw.Handler.GetBidReviews(c, tenderId, params)
+}
+
+// GetEmployees operation middleware
+func (siw *ServerInterfaceWrapper) GetEmployees(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEmployeesParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	return siw.Handler.GetEmployees(c, params)
+}
+
+// CreateEmployee operation middleware
+func (siw *ServerInterfaceWrapper) CreateEmployee(c *fiber.Ctx) error {
+
+	return siw.Handler.CreateEmployee(c)
+}
+
+// DeleteEmployee operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEmployee(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "employeeUsername" -------------
+	var employeeUsername EmployeeUsername
+
+	err = runtime.BindStyledParameterWithOptions("simple", "employeeUsername", c.Params("employeeUsername"), &employeeUsername, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter employeeUsername: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteEmployee(c, employeeUsername)
+}
+
+// GetEmployee operation middleware
+func (siw *ServerInterfaceWrapper) GetEmployee(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "employeeUsername" -------------
+	var employeeUsername EmployeeUsername
+
+	err = runtime.BindStyledParameterWithOptions("simple", "employeeUsername", c.Params("employeeUsername"), &employeeUsername, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter employeeUsername: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetEmployee(c, employeeUsername)
+}
+
+// EditEmployee operation middleware
+func (siw *ServerInterfaceWrapper) EditEmployee(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "employeeUsername" -------------
+	var employeeUsername EmployeeUsername
+
+	err = runtime.BindStyledParameterWithOptions("simple", "employeeUsername", c.Params("employeeUsername"), &employeeUsername, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter employeeUsername: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.EditEmployee(c, employeeUsername)
+}
+
+// GetEmployeePermissions operation middleware
+func (siw *ServerInterfaceWrapper) GetEmployeePermissions(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "employeeUsername" -------------
+	var employeeUsername EmployeeUsername
+
+	err = runtime.BindStyledParameterWithOptions("simple", "employeeUsername", c.Params("employeeUsername"), &employeeUsername, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter employeeUsername: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetEmployeePermissions(c, employeeUsername)
+}
+
+// GetOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizations(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	return siw.Handler.GetOrganizations(c, params)
+}
+
+// CreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganization(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreateOrganization(c)
+}
+
+// DeleteOrganization operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganization(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", c.Params("organizationId"), &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteOrganization(c, organizationId)
+}
+
+// GetOrganization operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganization(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", c.Params("organizationId"), &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrganization(c, organizationId)
+}
+
+// EditOrganization operation middleware
+func (siw *ServerInterfaceWrapper) EditOrganization(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", c.Params("organizationId"), &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.EditOrganization(c, organizationId)
+}
+
+// GetResponsibles operation middleware
+func (siw *ServerInterfaceWrapper) GetResponsibles(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", c.Params("organizationId"), &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetResponsiblesParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	return siw.Handler.GetResponsibles(c, organizationId, params)
+}
+
+// RemoveResponsible operation middleware
+func (siw *ServerInterfaceWrapper) RemoveResponsible(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", c.Params("organizationId"), &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	// ------------- Path parameter "employeeUsername" -------------
+	var employeeUsername EmployeeUsername
+
+	err = runtime.BindStyledParameterWithOptions("simple", "employeeUsername", c.Params("employeeUsername"), &employeeUsername, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter employeeUsername: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RemoveResponsible(c, organizationId, employeeUsername)
+}
+
+// AddResponsible operation middleware
+func (siw *ServerInterfaceWrapper) AddResponsible(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", c.Params("organizationId"), &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	// ------------- Path parameter "employeeUsername" -------------
+	var employeeUsername EmployeeUsername
+
+	err = runtime.BindStyledParameterWithOptions("simple", "employeeUsername", c.Params("employeeUsername"), &employeeUsername, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter employeeUsername: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddResponsibleParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", query, &params.Role)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter role: %w", err).Error())
+	}
+
+	return siw.Handler.AddResponsible(c, organizationId, employeeUsername, params)
+}
+
+// UpdateResponsibleRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateResponsibleRole(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", c.Params("organizationId"), &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	// ------------- Path parameter "employeeUsername" -------------
+	var employeeUsername EmployeeUsername
+
+	err = runtime.BindStyledParameterWithOptions("simple", "employeeUsername", c.Params("employeeUsername"), &employeeUsername, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter employeeUsername: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateResponsibleRoleParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "role" -------------
+
+	if paramValue := c.Query("role"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument role is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "role", query, &params.Role)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter role: %w", err).Error())
+	}
+
+	return siw.Handler.UpdateResponsibleRole(c, organizationId, employeeUsername, params)
+}
+
+// CheckServer operation middleware
+func (siw *ServerInterfaceWrapper) CheckServer(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CheckServer(c)
+}
+
+// GetTenders operation middleware
+func (siw *ServerInterfaceWrapper) GetTenders(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTendersParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "total", query, &params.Total)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter total: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "envelope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "envelope", query, &params.Envelope)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter envelope: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "service_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_type", query, &params.ServiceType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter service_type: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "organizationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationId", query, &params.OrganizationId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organizationId: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "createdFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdFrom", query, &params.CreatedFrom)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter createdFrom: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "createdTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTo", query, &params.CreatedTo)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter createdTo: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", query, &params.Version)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter version: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "creator" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "creator", query, &params.Creator)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter creator: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", query, &params.Sort)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sort: %w", err).Error())
+	}
+
+	return siw.Handler.GetTenders(c, params)
+}
+
+// GetUserTenders operation middleware
+func (siw *ServerInterfaceWrapper) GetUserTenders(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserTendersParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "total", query, &params.Total)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter total: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "envelope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "envelope", query, &params.Envelope)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter envelope: %w", err).Error())
+	}
+
+	return siw.Handler.GetUserTenders(c, params)
+}
+
+// CreateTender operation middleware
+func (siw *ServerInterfaceWrapper) CreateTender(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreateTender(c)
+}
+
+// SearchTenders operation middleware
+func (siw *ServerInterfaceWrapper) SearchTenders(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchTendersParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := c.Query("q"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument q is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", query, &params.Q)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter q: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "service_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_type", query, &params.ServiceType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter service_type: %w", err).Error())
+	}
+
+	return siw.Handler.SearchTenders(c, params)
+}
+
+// EditTender operation middleware
+func (siw *ServerInterfaceWrapper) EditTender(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", c.Params("tenderId"), &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tenderId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditTenderParams
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if value := c.Get("If-Match"); value != "" {
+		var IfMatch IfMatch
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value, &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	return siw.Handler.EditTender(c, tenderId, params)
+}
+
+// CancelTenderPublication operation middleware
+func (siw *ServerInterfaceWrapper) CancelTenderPublication(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", c.Params("tenderId"), &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tenderId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CancelTenderPublication(c, tenderId)
+}
+
+// GetTenderPublication operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderPublication(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", c.Params("tenderId"), &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tenderId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetTenderPublication(c, tenderId)
+}
+
+// ScheduleTenderPublication operation middleware
+func (siw *ServerInterfaceWrapper) ScheduleTenderPublication(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", c.Params("tenderId"), &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tenderId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ScheduleTenderPublication(c, tenderId)
+}
+
+// RollbackTender operation middleware
+func (siw *ServerInterfaceWrapper) RollbackTender(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", c.Params("tenderId"), &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tenderId: %w", err).Error())
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", c.Params("version"), &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter version: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackTenderParams
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if value := c.Get("If-Match"); value != "" {
+		var IfMatch IfMatch
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value, &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	return siw.Handler.RollbackTender(c, tenderId, version, params)
+}
+
+// GetTenderStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderStatus(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", c.Params("tenderId"), &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tenderId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetTenderStatus(c, tenderId)
+}
+
+// UpdateTenderStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTenderStatus(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", c.Params("tenderId"), &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tenderId: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTenderStatusParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "status" -------------
+
+	if paramValue := c.Query("status"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument status is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if value := c.Get("If-Match"); value != "" {
+		var IfMatch IfMatch
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value, &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	return siw.Handler.UpdateTenderStatus(c, tenderId, params)
+}
+
+// FiberServerOptions provides options for the Fiber server.
+type FiberServerOptions struct {
+	BaseURL     string
+	Middlewares []MiddlewareFunc
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router fiber.Router, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	for _, m := range options.Middlewares {
+		router.Use(fiber.Handler(m))
+	}
+
+	router.Post(options.BaseURL+"/auth/login", wrapper.Login)
+
+	router.Get(options.BaseURL+"/bids/my", wrapper.GetUserBids)
+
+	router.Post(options.BaseURL+"/bids/new", wrapper.CreateBid)
+
+	router.Get(options.BaseURL+"/bids/:bidId/decisions", wrapper.GetBidDecisions)
+
+	router.Patch(options.BaseURL+"/bids/:bidId/edit", wrapper.EditBid)
+
+	router.Put(options.BaseURL+"/bids/:bidId/feedback", wrapper.SubmitBidFeedback)
+
+	router.Put(options.BaseURL+"/bids/:bidId/rollback/:version", wrapper.RollbackBid)
+
+	router.Get(options.BaseURL+"/bids/:bidId/status", wrapper.GetBidStatus)
+
+	router.Put(options.BaseURL+"/bids/:bidId/status", wrapper.UpdateBidStatus)
+
+	router.Put(options.BaseURL+"/bids/:bidId/submit_decision", wrapper.SubmitBidDecision)
+
+	router.Get(options.BaseURL+"/bids/:bidId/versions", wrapper.GetBidVersions)
+
+	router.Get(options.BaseURL+"/bids/:bidId/versions/:version", wrapper.GetBidVersion)
+
+	router.Get(options.BaseURL+"/bids/:tenderId/list", wrapper.GetBidsForTender)
+
+	router.Get(options.BaseURL+"/bids/:tenderId/reviews", wrapper.GetBidReviews)
+
+	router.Get(options.BaseURL+"/employees", wrapper.GetEmployees)
+
+	router.Post(options.BaseURL+"/employees/new", wrapper.CreateEmployee)
+
+	router.Delete(options.BaseURL+"/employees/:employeeUsername", wrapper.DeleteEmployee)
+
+	router.Get(options.BaseURL+"/employees/:employeeUsername", wrapper.GetEmployee)
+
+	router.Patch(options.BaseURL+"/employees/:employeeUsername/edit", wrapper.EditEmployee)
+
+	router.Get(options.BaseURL+"/employees/:employeeUsername/permissions", wrapper.GetEmployeePermissions)
+
+	router.Get(options.BaseURL+"/organizations", wrapper.GetOrganizations)
+
+	router.Post(options.BaseURL+"/organizations/new", wrapper.CreateOrganization)
+
+	router.Delete(options.BaseURL+"/organizations/:organizationId", wrapper.DeleteOrganization)
+
+	router.Get(options.BaseURL+"/organizations/:organizationId", wrapper.GetOrganization)
+
+	router.Patch(options.BaseURL+"/organizations/:organizationId/edit", wrapper.EditOrganization)
+
+	router.Get(options.BaseURL+"/organizations/:organizationId/responsibles", wrapper.GetResponsibles)
+
+	router.Delete(options.BaseURL+"/organizations/:organizationId/responsibles/:employeeUsername", wrapper.RemoveResponsible)
+
+	router.Post(options.BaseURL+"/organizations/:organizationId/responsibles/:employeeUsername", wrapper.AddResponsible)
+
+	router.Put(options.BaseURL+"/organizations/:organizationId/responsibles/:employeeUsername", wrapper.UpdateResponsibleRole)
+
+	router.Get(options.BaseURL+"/ping", wrapper.CheckServer)
+
+	router.Get(options.BaseURL+"/tenders", wrapper.GetTenders)
+
+	router.Get(options.BaseURL+"/tenders/my", wrapper.GetUserTenders)
+
+	router.Post(options.BaseURL+"/tenders/new", wrapper.CreateTender)
+
+	router.Get(options.BaseURL+"/tenders/search", wrapper.SearchTenders)
+
+	router.Patch(options.BaseURL+"/tenders/:tenderId/edit", wrapper.EditTender)
+
+	router.Delete(options.BaseURL+"/tenders/:tenderId/publication", wrapper.CancelTenderPublication)
+
+	router.Get(options.BaseURL+"/tenders/:tenderId/publication", wrapper.GetTenderPublication)
+
+	router.Put(options.BaseURL+"/tenders/:tenderId/publication", wrapper.ScheduleTenderPublication)
+
+	router.Put(options.BaseURL+"/tenders/:tenderId/rollback/:version", wrapper.RollbackTender)
+
+	router.Get(options.BaseURL+"/tenders/:tenderId/status", wrapper.GetTenderStatus)
+
+	router.Put(options.BaseURL+"/tenders/:tenderId/status", wrapper.UpdateTenderStatus)
+
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+x9aXMbR5bgX6munQ/2bBEEdXTb3JjY0GHveNZtKyR1z8SaWgEkihJGYBUbKMjWqBnB",
+	"w5LcS7U44/VGO3qnrXH3RuynjSiBhAkeAP9C1l+YX7Lx3svMyqzKQhVAiqQkfJFAoI7Ml+8+H9sL/tKy",
+	"77le0LJnH9v33WrNbeLHj25X78H/Nbe10KwvB3Xfs2dt9ic2YIesG61a0Trrsv1oI/od67I9i3Xg22iN",
+	"9VjPYgP2Kvof+Ps6Cy3Wsdg+C1kn2oyewafoiWOxPgvZUbTKevyBlTn74pxdKdmO3Vq47y5V4fXBo2XX",
+	"nrVbQbPu3bNXVhz7Hz5zvwqutZstv2lY3x+jDVzFAFa4xg5Yl+1EG9ELvspoLVqPVlnI+qwXPY02LbbD",
+	"DqItix2xEL+HpeAVVmUB31EpWewlrA+exEL8eS3acmD/A3YQPWf7bGCxLr6sl3gB7H0XtgmXsj7rwqbn",
+	"vOgp68LVcCs7tABgu3BpnyB5yAZsO9qI1i32KtqM1qPn8Pzfsa4G12izNOflweq2H1Qb1/y2Fxhg9QN7",
+	"hWDpWtEz1sMNDPSjG8DJHbEB30W0ygbRWrRhsVesy3ataCN6BgCBtR+xkG2zHmwwegpIMBrclBd8A2Cy",
+	"KgEs/W+CZts1o0TdC9x7btNegY0uV5vVJTfguOsuLTf8R677q5bb9KpLLnxXhy0vV4P7tmPTd+nLHLvp",
+	"/qZdb7o1exZerL71r5ruoj1r/4fpmGKm6dfWdFs8AJZSX/xlNVi4n4Y3UJRGJg5QxQDgEa0CjsKPPbYD",
+	"uAG/AIJ0WT9aL1nsfwkwKadjRWtIhNETPKNo1WI9tgsYDMjHDgjc6slZ+DZ48oD1oy1xJvAgRMUdRMdL",
+	"MxdKcx6uNlpDXO7gAzvxKQo0H7BtNnCI0EJAVsDRXcSBZ7SMaEvsKrQqfz9NJE448BPrJhYUvRiyoDmP",
+	"/QtiHe5oGylqwDrATmDjRHxskHg/67JDq/LXFQXwsKQ+vBuhwr+XwCCiQmwhbhjjyyeLU3S0w4nOb96r",
+	"evV/qsKxf1LLwL3EReNiXuIxRAr36h5+kckk/6BDyATRyj9MAZ+domdUBISJk0VbVsWTXBh/3KWj7HB2",
+	"EK0ReIH7biIHNvFf+KpksR81Nh1GW3Nemo3SipG/qFgI1+xGG0QByO+Vj2wHWep2kq2FxsU5dOfvETkP",
+	"4Zl9AAchtcZ4CX+iNbaDuL3NQsDclHBRUOk3bbf5KD59Ei0aHi1Vv/rU9e4F9+3ZmXLZMeBVfLAfeQ/d",
+	"hr/sGo72W2S5fZAe0fPkijaS3P2QDorOlB2ynlWpB+5Sq+IkD5jzY6QjkJFrcD+IqjBaQ27WYWHJ0sSv",
+	"YFcoYua8PBkzxc/kJzyzQzpTKT8ka4CndgwYGz0pWYJBpIU5Kiv0alx6jEPaFgbs0IGV4rJeiYPGl8Bl",
+	"KIkVxgzv1pj4JqGfJgmjF/wpsPsjLvt2EdnXszHEFUes4kjNXay2G4E9u1httFyJI/O+33CrXgJJPq0v",
+	"1U1S/19ZyPZxv4csxMUAmus6AEBil3UAdECReB6bIGcSB1ey2Pf6rmK4csVKET941NFaCkLIBpDB/4gH",
+	"jhzZQuZ/AFKC9VMroqM4zNgK3noUbeBR99hhan/JbWQeQwOBaDyDy4696DeXqgHpIhcv2A4QcX2pvWTP",
+	"Xi479lLdoz/KTlpnUU/q88XFlms6qj/C/mhH+wiMHupba8hoTWQkQNZnA0V3JDl3xPkc/AaHQAw1BMl9",
+	"0sf4A6exDjLhvngI3SrZBx4MfZpVFMFspgvceQseqmgK8DT2EzBxfY1A/D0nre8nmTYX+exbuSxg7FL5",
+	"55x+A5FnPdpUlaBsrPHpQI1oUzahTVFMQXXegCgvEZC4RkEbg2LKfY8rRlJkCHlp4LFd0ApwCVNoUlQc",
+	"i0R9RXArUteltdKb8zhzR8HMRUg23PD30TjeCmhOrWXfa7mo+s9Xazfd37TdFtLTgu8FLtk+1eXlRn0B",
+	"oTi93PTnG+7Sf/zHFoDvcUFdy202/eZN/jJ6dco47kpoboK28zVIBRIdCfSUChUHd0JkRZsle8WB9S82",
+	"6gtnsZfvgJw5q+lJbRnJtoO76hK+6X4AENso/tdQIm4h3b3AvSz6zfl6reZ6Z3QwO0L048qeEY88IvtG",
+	"sjlgPKqx3iMWqIAi2sLdeH7wsd/2amewmR8UE5A0C9CJ93CZfVxc26u2g/t+s/5P7lks8KWQI8g3QtK9",
+	"oud8sSEwGq4/9aKvUb/vcUssZH1JGKRiE14R8EkslZAn8tUgyddrBpb4Pesr5PeUTnIgFX9kaj/RGbOe",
+	"7djuV9Wl5Qaq1AQ8MN3sn89UL31webE85V74cH7q0kzt0lT1FzM/n7p06ec/v3z50qVyuVy2HX7HbWJO",
+	"nys2me3YC023Gri1K8DwL5TLP58qz0yVL9yeuTxbvjRbvvzfxCV+M3ZX2IHbCu6CW8E2EOZAitceeI8G",
+	"7BV5ENiOAKK06iyUdvhfP9oCtgu7uny57H5wqZy3K74W7Y37pEzTwa6SDPlnFPOgiHXZHrDvoBq0W/as",
+	"fY22bjt24Ho1t/nJCC9/6DZbuOMZkIBNf9ltBnXi8fH5DMfU+Xrtirh0RT+kgjfixSvaGaYQTdFZURNC",
+	"hhGSlQnSFfHtEJkLufYOCfnJcGDbcLUV615JmgGWy7kUUIYZgYkHkIK1Cw+kb0GxMfjgYCWqaGJd6+bH",
+	"1y5evPghiWZJCln4KhWYWjVwp4I6us4SBqsBq4s60RIon3tW15WrVwjFc+8hlCiyrvl67TO+LIHYuTfc",
+	"ogtXVMQffpO8bkVB/dz3/JpfubKiOpC+ABDwzemwlFtQFqZit0YkTkxo6cOMV3lHHr0//4/uQgA7UCkv",
+	"TTF/QezcFyYbqkrc8SmFAvxMRrWFgQP8yEIj+qObE74HNRcMRfiWHhutKdQ5YIclDb0LsiKJ7u02AjaF",
+	"6Tq/SG/4z6zHjrR9gHPctBFiBJq/YwBivifcuhbev80pfFc421O+K3XjBns+WrdUMYVg8cAC+SIpvuDE",
+	"7TvmTV93F+oCUxNb/jfWJUc+Vx4zRG/0QnnzleXlpv8Q5cVNF1DJreW9+dd+YAL4/ySrRXi8O5zz4SfA",
+	"DXJc5yxLFzqaCCjGAGsKeHJZGL9U8q9cpGuPzFdNTKIdE7RcrsoRMqj7us6gUxrqEdKi1ESMRGs7qtPz",
+	"ssHpia/62HVr89WFB6b3ROtsF4xyEn9m0ai/Zqac9Z4TYVXnlDt9xvHEpKgLnxkYEbuq+ljg0GaygHmj",
+	"es/0wh+TXn3Tie05ijcoFRbUXQ2lFJ2i/1r7kEN69orcQrXZrD6Cv70TDDE7FPvez3apqIGWkukEgwy3",
+	"jzmAa4SpKYybvzLV2VOyDZ4rg7dKYzF4Bhk85Kb7sO5+OZysixlsBUws/RVXGg3rnu/7fu1nP/vZz0Yy",
+	"i1aGCoYztg0GRRji2VoFo6n2hCPjKPh05ye1NE7WUkpxrrhLL+NkhF62NJLLP75MkkjBwrOXRLekCZUW",
+	"DbBkCNpkg05oirFj4UZ7vlFv3cfP16regtvIVhp/HdtV0q8842RnOOkpTRRV7goTPEQI7tvD3PkzaQbp",
+	"yKwTI7sYROvck4NnfBJa6GK92Qo+K6AoioUJa7egHtqojvf4E9df440qi8ojbm1Vehj+sok0de9nAS4A",
+	"ikuPvWL7rGfmsNYvPij/wnqvkuWZrbwPcXUl6ByyQ0XvQFsOwnvoRMXgD3HzPuaw+TW3MjvncSf9Glzb",
+	"sSo1N6jWGxTXb7pVeEtMdKQIygwRGb17RiF0tKH2uQQ7JAKFx5Lo2hILSAgNcELXyLMJfoe7nh/cXUQH",
+	"umPTauxZm/zCbIeoL+naxiSZKvqq868UrppL5UuOHdSDhsvjANqFbBBjdHXebwez842q98Ag5P2a6bSR",
+	"abFXrKeyYjjbbzAsOhAhOQqgsz0S6zsaVpgyIZNAgnyM6sKC22rdrble3cUv2i39kjmvUvceVhv12t0m",
+	"hcBk2k4Fv0bcurtYrTfcWiUp1A3nYpDddFCjYz3Jm1eweYCLGhvrasvIPVgzRbaMqaoh5tV9EztXIK9S",
+	"qObPpGqMoe9u9FQRnU8lzycd+SdKReOJRuwgelGy2B/izM44f8xsvKypKWfRN7EY4flnEEDjcdEiZsti",
+	"3W3UPoKNm6wXQSUne0yYqAXLhbA/1xsx+MuZiWMpYYIDnmiQG/kvkbyUPNdwwK0MleFvb9++MRWtqXqD",
+	"koKmYhUxgZQk5mzBYOAhIxWpF4ME1FLv5elpVrSFetY+62auZDgLivccDHUkEuK8Qigf8swIKUq016lM",
+	"zZRTpsrTgJy+BBXFU8yp3iEmKNHLJE0VvMylR9Y10iM3hgQGHsbpA3oAPaUa4buNgcBDc4K3TGkUmQ87",
+	"3CVIKEsZY2HitSgGUVDuWjyQvB9taDDn6kjqTJfcVsvsFknRZT/JurQX8Cwjix5tNZB2rHrLmimX0y9O",
+	"nDKBKV6N6RjVrFLTctMe6GgrdR5jOUw1Ayv1e77hl8yHjUM8mSRW9HEUCywcaOHUNFz7TKcKH9vWywgO",
+	"nKHVp27yBuTpI3royJJ38l5aPTf7Hsc+1aFnc8NtLtVbYDy2jLGOAfljerFpSMFY3Zpjocba9jKOK0VJ",
+	"aSwZjQSW9dVLDUNY0+ods0tVD9iCCBDOoo9O/iV/na/XxE/wUfu+SY69O5nHo6gqfmOk47rpN+i4VCJM",
+	"59L7KMHUfedR302/4Q452uzDzDhBCdsvPbcp4XeX4NQkQN2l+CqKVIAYfs8/3MkhpIxIo4E1swFFvKYU",
+	"t0UHfZGxLaos+JOPbMf+9NNrtmP/3a1rxnUsV1utL/1mzRhRoKyQLJhpTmT5HM0n9osLmkb4gWkB5PQx",
+	"uly/jX2qoIPFHiROckfRBmpOqp7/Hnd0gqX9raWaIBS7TPtK9SKmjqrvZeRiOOj6hXtAqs95hoVg9GBD",
+	"HtMBGRVWhScHtKYfi6D9yjSCgLwFlRP1z/Jsyvo8z4VqND5ftGe/KObbsVecJGc/EQrHh6RJ+A5YB+15",
+	"TuTX3WqtUffMdjog5X4cXX8meHU6UKIiw8u4qEQWkij5yUfmEL609Hr4yMM4mo8Js2ygnb1I2eIiWUG+",
+	"OU+kDHB/EU//JnNtF5PfVxM5yZRX0EuiX08TTvusG+eKo4tH5op34POGLIo5PFHcIvQtnqynU+IxYz7s",
+	"T9GGSI3fUXPoZL60lkU3ELo4zwzF9Ht2CJo62L/C6uKWGBWAYDEeZ3W9E0+2g1f+EX1zIetHz60pi/0r",
+	"XMz24Xc7rU0WzV5suc2H9QWXpy9edxv1h5SWbUjkG5KWt9DwW27t6iOjRb3OBirKHmhnO4sJLiL7JR3q",
+	"2nKsSutRK3CXKop7RXcVyWdH69YwsoFDA1UYD0v4gHpYcYY8goVFuIQjPWvVL6vNWrFlIU1ug1HJ/SGh",
+	"rFA0s5KsSJ0mfaIN7S1Yp6MRjixwiamYgJmZtHc+oplJV+B5y28cIZJJjG/kMKaaFFgkVELXiwDLsc0G",
+	"Vcsadmt84UqCmRRZ7i3lhsI5lvxWmWZp1gKGPcFwR/HUS3r9GNmXyl4VD1vKgBHLyPMfpPEq37mk8Yaw",
+	"SBKWmsN6PP+E/u4z9kso1DJiatQwEM4MAWHBrKgk9z7FfCha59uWEpUE6HlLhuLYEZt0GUlRqjGL4jdl",
+	"Q0Zb+mbDLLfs1UdZSJ8WzU46CVmWPItMWjQaZCw6bd5oyyolIo9xrYs5umfCOFMcVVKJCS5qqwlcSqgv",
+	"Q4uv2nleh+LyMCN6VbnherW6d69i/fvqdxYebE8oMY5Vue57Lv2kq0FG4DpW5WOK6tLDim3fsSoiY0Ys",
+	"IlrnR0zwmfMUxxBfr+3YsDbbsemVeXk34xRAtJdrmUqo4t8x9nmIW6AIJbqgQpeMhsX1EfF5KjI7piN1",
+	"vdnUfcutNhfu33Rb7YZpW39SIoJpT4Bu+jYhmjdbLl1w7JZXX152YWfsn6O16GvuYVpXK+qEGdvh9Nqx",
+	"2F84KyHbd65dLl9cWKo2H+Anl4Xqo+jX6fhni4rPxQPD6En6CdJOZb3U/bbqCBjdjNfXrq/VsDR1KWOa",
+	"5MUhe2JG+DXfawXN9oJetTOrpbsppnjKFicUMVVlIL3g6qN1TFEB2fwcgvt6qx+CLrF8ivRjD4g1MswU",
+	"8xNjodEGh05IpfoixrGdkLMJD3DNb883FFr02kvzpH5IxE7t4f/gOreFcUlNOhT9jPcI0aL2vKVQP0Fk",
+	"h+QoO+DniR0D0J0TF8TTRZAzlcRxaAz2Z5lVpUtdK/o92OP85RJf5jyuY/zt7V9+airZAdgfsSORUDUQ",
+	"/qpOnFhBGVaUrZFs7kIPpySL6Dmx7yFOuCKKoJEr2g4hWHxMw/ieZg8muTkIPQuTKA6iDegmAXqYrl/s",
+	"kWBCXCWqF04QjT+K1FCdchRv1i+rXnuxuhC0m+4QSVUoQTXr3ea0VPSLDXnl+chLDfwHrmfMOQGvGE8E",
+	"JHaxwY5YKD2jhuZRV3j5OYUUratutek2uZTA9wgSSqmn7lfL9abbGiVrQC48R6DjZY7yBhPSqlmpBWzd",
+	"VqN9L9NrWVjNRbfJQrtZDx7dAvrjrSwQagDK+K+PBUT+7u9v286Qo4KOXJUbn9+6bU1DlHG64d+re3Ej",
+	"PXg7PTFezf0gWKZ6/rq36KcBcOXGJ+LM1SiVjHvoLJD4Zi/Dswm/lizRLIZiKgjSrhV9HW2wPtvnsTl8",
+	"K6DVQfQiekahFMP7U1Y5vv89QxQuoSDid3SAG0pLtGiNy499Fr4P+zC+MntzJ/VqaqiT2VFhIFfQFfFC",
+	"8oCCgUsHPBWtC7SINjIRg2czcmt4U3Z4lPeyUOuOobbgCfH8KNTVZ4M5DyUmcHYQVolYaXbwSz9B0T5N",
+	"5O7eRlZp/RID6EuuFwBmqFqQPVPC6hx/2fWqy3V71r5YKpdmgMNUg/tIUcqO4c9lv5XRFpNOSbN4M2ic",
+	"UFxGvXnedQryQHjA5mK18FNcBbEotxVc9WuPhrTqSLfo0NmmGpIfapqK604iAb8d597L56Z5qn4XeKmS",
+	"PXsulMsjbX2o3oJc3tSVRGGOgMdIl9gt5VK5nPVUucxppa8Q3jKTf4vWhUXl8fbsF3fAZb20VG0+iv0u",
+	"Gg/Q6E6Tu/goqHBqTS8hytwzqskvh/KVrOC31sxnmw0y8Z740ndCGuxgZymqsA4Tre5iaynaUlk53xwF",
+	"24hr/8TXluoli6xAJ6D/4gZQm361XmvZegPYjGSF+JLpZJe8FWeEW3i7tpHu4Z7Tke6hZl8j3SIbQ67c",
+	"OSaN+Z7L8z6OUUebew86xFfumMj1R46slLaRWdJqjhaTv5JnaoATYN/iCdsQfMZ4gtLqWnM3Z+2UXz+t",
+	"tp+GDsuqWzj3XqUd88rKsVjPeWrpZOrSpLZxQmtuNVrlfeeoS5XOD3Vt94s7K7kMUmNmHSq5wU7IJlRR",
+	"eKbnfjlE9v+o62yZKT6irEHfePRC8k1dGZVaHM8mGi3ZIPGwMVo6V+Jo590aD3feBZktC4F03kqG7NV6",
+	"7cQUlGN19BmxTc9xA9+ju6wTipE5ITx2KCdeadaZUm2/Yj2Z20uiiYkxdcQ+Ta0L2b+JbRh7VaG75wgb",
+	"xFAzUoXoBlhmpLSuiSPEYdyD3EITcZTEeC0/RTfNeEe/d5gXw04uvhVtGS+VL53BPv48rGaRr+vDfOSS",
+	"3T5HE44pmZXPHRR5+Bhb36xMi+4/rWyTQunpJAKKqc5KvE20KSs+JcY6fG5BtMV24qq5OEm1VzIp/Vfj",
+	"bkkGxd/Qah83OHaHfbp75dgKdVE1WutslVKp8xTlbdH4iueNUOMvJZIgumIP2N4pGr+SwQy/KW4RG5Py",
+	"8DtkG9bRSEb2B+MKWFYTsDSZuDVqqr5snvEBNIKkuG+IFA5RF7OFuI7/H9XqAallp4X3+eanGHhCJPJm",
+	"KYsrRVQvnmIqE4pi72+iplR01EZDRLE+9Ykke0pO+Su6TPGiZ2YCz3nxFJjd5ACPfrJQpc8GGMvsqzMP",
+	"+rF/mNy7KY/0HlkDb4jmmFj+gNQ8Q6/DFKT7NG+nl6o7eJHwDYgxWMPMerxGtedPvVW3Zhp01Sxqmolg",
+	"RWtynwpXijZV1ZDU4oRUpzkVFqJ3l70Sd1KYozRRgidK8HCKTanDbEBrnLlwwjzEOZVdfqtOkYJYmhgK",
+	"ZXHFKkQwHLBw1siKRMRsH9352vyVRNt8gJucDwAshk9Kojlbe/iV9Z6cqfX+nAd34VCwZ1RTImla69nh",
+	"8N5wMmMuc5VxcmWY9Cd96VYf3HWD6j3uPhqTY46ktA1TrcyiuKjts6i0GF1uBxnZt5Kr8oKxuNdetgaZ",
+	"1uBugScuuKr0NT1VXc40dmNeW8zYr5DPOL6xNI7G8EOR80hpEKrFeaBIgrOQ41o7267WXEvWZ2atdyKD",
+	"JzJ4NBk8Eu/9QXPMhFpTyREt6KbfaACbmH7MUzdWhrPdfV7DSaOskmNyMiM0++Aj3pe5v7z6X8mcK1ns",
+	"/8FRW/HkpFj0CRfanlo0PTDNCOzoCkEf8z67IjsVBFU8Tas05xm2ZD4zhfi5nJbt49Vxl3qDhUzP25qa",
+	"HHhoVeKeHcb4z01+RqfuaiiU82hMEe3H1d2DQiAu2Y5pK3HpXfZmRsqsHNmDci4t7RimoWJp56G+1NHC",
+	"N9CsPvFpXhMpPZHSQ6Q0h3p6TrEiuaPNifU8sZ7HsZ6l3pEsHyhoI8d1jHn5hj05ejinoTdXHAmQyYi+",
+	"0K8yY/rRRlZ48Jao0XtTYoMFp0llRJ4pXbPH66xzYD5JdZjIrnNpYRpzoPVc5GTP3QzW5WQYk9/LYNEZ",
+	"cqhfYanwWTCpDOefLGge++mSPZ1zQyd/wsTw+OLEhJmIgYkYmAT7JubKKZgr3ydLJYtK/7ThggG3u+ow",
+	"xqIxvmg1znhkXes9DpJX0Sq/QLAZtbcK/vL+mEHB6/EMxjPWC5RpkGM/X+7mbCKChWeQ5kcFgZG9cdI/",
+	"sf/iMUXMHUsOpckePN3NquodyEhFVk/MiVIxUSrGVSrKH57FGjOwBqNPYsCPnoYNgl8SwuoIPCl+Yo8m",
+	"ax0/bqu+X5q3RSO3PDQ2xAn4I+8CsJ/pWVS6dqmTlbQ4Kx+JBa5E3uguWlPu492+9rLcf78WyzxPSdIn",
+	"UnF8WjUIheoOvs31IhN6gVzgTcoJsclzwgsj390ahO+5/r8q6uIFOPeK67aCIvVciuG0mVVQTWSmJ01A",
+	"sbR6XmAOaDkUw0nwDLTY1x3MPz8RetbJzXB5d4lLs9xzqSkeq9Got4JsEkr7qLO6w0PBarQlToZ6shuJ",
+	"K9Ga9jCLolof+83bovlaPlEpxcbj0ZVa2zxpp/EutNN447pmTHzpE7P3raselzMpTy/MWqAtFIdkorW4",
+	"UYLSKLMhNuIP5rJxeMsuC40ZrGA0KI4rsgQPKfmVdeMcT/BhH1AZnMxP5v1hM00UmVWL8whkUqmYQyBK",
+	"6w8yz4QjmbHtS4Ywv8lhdAaSPGs4akZzOwU6Q8VGAtYqGLtabq7gkdrpyVTchA+aNMxfxW3mxoOA2sDu",
+	"7TLUCZFGbxMgT4zqpIbQR8rE0FPRdQt+IpEnEvltlcgJJmdIyx1NDut8MJskFZk2xI4VAxhVuZuSPB/J",
+	"i86kX+KpMEVlEmURnpgcUNojcUajbBI1DLzAngbI5TaGHc/5spLo7BPzbMM01QHrJE4/2d3O1NVNYMGJ",
+	"tXZbrDdbwWcFum6IZYo+bY3qePdNmt2mkL0IcqNIRBayTQMQo1UVv8f3Fh6jtVW6wjtemxxolMb8MIn3",
+	"j8VHoSyukOXRcAPX3OYxCZvYvsBvQ+D6lASiTbtYgy4Yps481/FdCnGNxmGT6zexy0uFdiKXnygOfr3u",
+	"3JGE31/iFQoT1HDCTq4gey1QPiXyVJu2mCdlj+ci1/rC6Q/NJ5rc9lZDCUdJlzWTDug2PYvtxFsvWag0",
+	"6p2LYkErOqpj4kpX7Tpvbo110ojxBorHlfMsk35ItWHaJBdKIVo4n4GsE+thMp6cm152m7y179DWjQMy",
+	"Y5Qp4KHxjXyEVgckHcQAuMFs8sptRU9KFvtOaU7fJxeYRvQhO4wHHCVel1HDJcj4hrK1s2f1hewPtbWv",
+	"uvwi5shLeTDsKBPmetetc4TaL4eiFSGyCp2hpurn2oVvr7mqAqQQjvxg6u9Q0GjVx6S9OGkL1YSvovW6",
+	"dvBFe7DHyXWifeGAp2bTkG/Mj6fJN8+jp5QGb1qDIU2FTGEVy45hDhfmB4BurdMWyDqKFUOpZI/s8MwG",
+	"pYza+9h4/iYcfKw3YR9uL3KDhfrrZ4aoOuhOBlztYsZi1zyUavhQrAzDMoGqo/HDZIv7YqZlBl4o5uU7",
+	"nb+XNGKzUM8pJORew5meIgtRjdksFjwuohzDAjZicBFmkGsHn6TRevJo8G6KsXzb8qRR843nYcPM0fFF",
+	"qVhXfb4xPCB1U73uuJj/NoX2FQAWsgr+gpMx16J1bm71Mk5PdiencYVnHu46Nn83pxKdGPLmxhUSbfPc",
+	"Jf+hq2D1KSD1yQUOeBKYHM5HJc/vAs98vfNZvtfBKpirAXUpvQZDnGbT+F+oa3+y2XFoNf2Ga+wHGY+X",
+	"5YOTVol+LUgccptpjeRKrXbWCJxRkQx7tJ0x1IWbcOPK+HSBNd9xdSwOrjGagBOqKE4V3+lALUYVbYMe",
+	"QR11FJy96Z9LvB0vd3FsPP43TueJGRnhBEdH8S4R4EKpL+WgKKgXyzDSPTMQgy2HgQ//Hr1CwJ57rI/B",
+	"U3VmbtwzWObMHXHdDIxO1PC2cS3wVZ/n3GFLYDk7D5OpsVlFCJQWrVMVJqVL76rTvvkk9v+L6YSy1n/A",
+	"trmUOYRHvUKWiP6vn9iOeA4ZwR3Kv1S6KOAXMbAsmlpD6uKhKO/t00+pbEDuN+ODqjq8fApdvH0UfT3W",
+	"ZR3j4Mr77sKDW27zIdZJ5SjqgftVML3cqNYTKno8s99/YBjWb0q1UQYWymMpCn3Hgq3q2CXE9Rws2/r8",
+	"v87Z0Dsa8hIP2CC+bJ33dsEJqJRi7VjmFEyRKLuBK5iz/Qf4TCChywSZYZvCd5zIztb1Ri5QUXq5XJZp",
+	"lgfRC5wyAzUHKIW3edvtfaqPY13rQrlcSloCLzXiSIzMziIPolfKjx9a1K5k4enleh308yaGrOF7n0MF",
+	"/9c0gSfOZuIGFDymBx0ziGQOYJv6hCf1Vplwusv7+vQRsolOPvE4fgzdJmfpW/LRNHA2eoL/bkHnyOiJ",
+	"drVIQKX2QZkNg5RJcnENyYDtZ8zqvs3BPBnVnawtTFdlfKvBPLZq9TMlxfQAkC7aNA5vEiZ69DyRx091",
+	"3R1sXRiyw2w0XNPLBuWJO2YMlf1iNK8nIoSx96DbfFhfcO8ih3VMHPgL+5rvtYJme4F7R6+7jfpDeMgd",
+	"p5grhcj7Fr3p9qNlk0PlZE4gbqiDDHKH6ubJik6CP3qSZIOdrCIY2aBxRB8S3zjdXWTPf1bzNvR9mgrN",
+	"MyOtpk0k1OtxtN9PaiOu2tFjmHSAwLJ/z3UwSkyh3p1x5R3eAp1X3rv58bWLFy9++H7WphYwiFz7uOkv",
+	"2caOAmCYTAV1TBpOahHH3gnK3370HNq58D1Rqo6yKxYW3sVt//XuIV3/TpJDbfKqtS/gHV5MS47bOoxS",
+	"AieaUpwE7A3c1OgYBW13KOD9pj1eGZuxiGjLSrlywVSInvFQ1a5Q0LaQ77xwrMpURRkcyXeBOvq/r35n",
+	"buAya1VafjP4mymOOVcCB5YFzQH1ZLBo06rgL45VkdfCH/z84CNxtwrYB5VWzKQrJQt1up7Fs4oAnbnB",
+	"BdeyVxmesDkvyaJ7bAeU3tzqdhR8P5oAOOCttYniWC96Cs89oER1RfNSwFzxUfOozFqI3Kv0VOyThrdt",
+	"yknmnOH0eBKBlj2XmpA854kTOcTLnokzSc2lqWjl+RVpJRiVOcdioVVZEJcm8hjwtCtak8NoS9xKwj22",
+	"mBJIkSXT/Gag4f1S9atPXe8eWOEXymXQEYPAbcKd/33qP78Hd/1WPvi3HH1+S7jzWwVt3n/PGeny9//6",
+	"rwxs7ZSbPRB3GrnfA91WsOVDUr+fNHs41dLSlVFq8JNnpZmp00uPRulPM/TB6Q7rmdE9ZI7fkYTZ4E1Q",
+	"iR2HCc+VOiHWYh0t8hFy45Mv4SfecoCFUPUDv3Ml0mxBgqN1YkWekw41Z8G0MtHzjeNm7+LkjTHblMhK",
+	"Ik2py0AEnVUWTDOOm6vQzL90Hw/08knvGyn8rJdi9fgpM9lYdu869Rn5BI8xxuTTjaIeKWGMj2i6Q/Ru",
+	"vlFv3b8S5N0aX4g4E3tuxnH1YPtvLMK47lZrjbqX+xjDHcliYK7aqmegrzQFLXOp8PBB7lnoWDrVCf2C",
+	"0+f0SUi00FaNZuxlrwQT0EuI5YAh60lDKDlehSJImcNVaAIhDRxMpqtP5hy9fS1AzktEuLDQ0GVRy602",
+	"F+4PV91RTzfX6iC2c+eB/C4tpEj3JyeAhT58XJ2wXiyIqEVr8Bq+ZHgsPG4bsIntKb9A98poE//skPr/",
+	"h9jqsXAl38TBIKDafeGvoI3gq4Fgu7PxUtBa+F20Ie5TXA2OVZmzo68RTbARWIceCwrdM/gUPZmzK9It",
+	"heHBLbbjWBW/yb8WwUPwk6DXnb8W3FwxNPjF5EFSHKfxIksWZsZCFB4jHBTkzEyV5AqJxYeBdPm363Hg",
+	"8T/pozkkvncSB816cx5yyTXw0Fm4mQNy8Tpy0kcngQk4yvi7mHLAsQf8FNw3cQtxnGrBDhCwyFWxFzAt",
+	"z0pEHDkOmuJDsAyAGLFf1b7s6q43xBsl6gg7fibMzHQfu5OKNN5COss0E7OojgwH3oNOYHlJ82xhgu3X",
+	"JJ3gbP4oHMDR8wwv12+GZv0kXV5LdU/8PWN0tZ+SiZti6PEpvWNRyBOLL55KzrhYCOD/TbfVbgSFUsfz",
+	"zlfY1q94YKBrYHPC5JZ5ZuaLZOnyaZURJuxJ5Mfk8pKZRdhtXcre4a43pctlXn1QenxRylik7BFdtYte",
+	"xMMOczpJQuXQuW0Irc/5ezPN3XNjeObbjS8p7oTekjV2MBznRAmI6i3b5Ql5iX6vmKKr1lT1Uk1gdU6d",
+	"eJDoWKtXyoGsH4g5S6KyWwp7HtjrJQhoj/j3eTd79XWTkpRWa9KAhbw8UPJZX4k0PBXDwt6wuVMJN0ZX",
+	"1T9pAp4Vrcl9JhTp2K41KhNSuUWEeqVGG/TOGBMLftLEU1JpyuA48bGUkl1MJlO+5ZMph7ZwGuIBUrRH",
+	"dHAThgxtOwH1jWo/NTJOEfj9RK0mig91Bh8XH5QYgrBjAwnxNPEaNMxrVW/BbZCOeUNZ8Wmrm3deu5RX",
+	"d2dk7im4blE6zKSuZiwvaozXoQFpWS9FR06G1/QPWfSAU+PSj06pr5y3AY8jP2ApO3f93aYBOLO4W002",
+	"gN/hbgpFQWRA7+V2YfQW4y5SrD6F2h09x7xrVSgSXaso2c5kmfW5ctqTYyeHipqSxb4VruvY4Otz17sS",
+	"ldPmviprVuvbBqQM53UYcLLW1Im+ETqEASqwVLme2FfJBnq+Pla38UyoQwgfJPKr16JVIUYphQk2nTGo",
+	"Zc/omF6479baDfec8JKT8MmME9BPBNLjX866l/YxNIEsUnmXu2O9NMCDD8zLF/cZanPTbzTmqwsP9GmX",
+	"2cPcKVeAq86ptMwUu9zPnadoUfkucANMTFKtFBEI1ibZUuminpkdP5Fstz6WNnZlyUYPyx67WR2jbnIg",
+	"nJ3vN+UvGIgJ4QqsoGJTmQNEgFHmAA0S56MehiyYeN3DPEd1ZJ8vV2MMwlC6GvMwS+VNb5xfcTLvZ+Iq",
+	"PLV5PxotGef9TByIEwfiOA5EqZpoArOoEsTLgQvUgHDBqpiAKZUH03wIfMn0S6GtZCZgZvRuv62WHb99",
+	"bhK+sSwuQsU0PV4wmAH3SW7qRFy9zshWbrVZsuxLxVUWFnZPfZ8c91KQ1QzlKTLVdB9LdzrIu/eSnqQM",
+	"nETZ84R7brZiCdCxKgsNv+XWrj6CymJNq16zeCEvwARedhh7ewbssIi/B0VWl1aEAkezcqXXzuQWou5l",
+	"Z8o0M9qWyb4Tx3mBZJfn39T6MRt5hyZ5TAypiWSaSKZJzsXEZHp9JlM6nTZHZcl7vPMY34CNAo2VAf+b",
+	"oj1KKZraO866cuMT27HbzYY9a98PguXZ6emGv1Bt3PdbwewH5Q/K09XlOpQ4//8BAAJgGJdiIQEA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
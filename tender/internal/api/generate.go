@@ -0,0 +1,5 @@
+// Package api содержит DTO и интерфейс сервера, сгенерированные из openapi.yml.
+// После правки спецификации код обновляется командой go generate ./internal/api.
+package api
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen --config=oapi-codegen.yaml ../../openapi.yml
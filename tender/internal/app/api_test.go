@@ -0,0 +1,557 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"zadanie-6105/config"
+	"zadanie-6105/internal/repository"
+	"zadanie-6105/internal/repository/memory"
+	"zadanie-6105/internal/service"
+	"zadanie-6105/migrations"
+	"zadanie-6105/pkg/migrate"
+	"zadanie-6105/pkg/postgres"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Сквозные тесты гоняют приложение целиком через app.Test поверх хранилища в памяти, а если задан
+// TEST_POSTGRES_CONN — поверх Postgres, где каждый тест получает собственную схему.
+// Каждый ответ сверяется с openapi.yml: маршрут, которого нет в контракте,
+// недокументированный код ответа или расхождение со схемой валит тест. Запрос, нарушающий
+// контракт, допустим только в негативных сценариях: сервер обязан отклонить его с кодом 4xx.
+
+const (
+	specPath     = "../../openapi.yml"
+	specServer   = "http://localhost:8080"
+	testPassword = "password123"
+)
+
+var (
+	specOnce   sync.Once
+	specRouter routers.Router
+	specErr    error
+)
+
+func loadSpec(t *testing.T) routers.Router {
+	t.Helper()
+	specOnce.Do(func() {
+		loader := openapi3.NewLoader()
+		doc, err := loader.LoadFromFile(specPath)
+		if err != nil {
+			specErr = err
+			return
+		}
+		err = doc.Validate(loader.Context)
+		if err != nil {
+			specErr = err
+			return
+		}
+		specRouter, specErr = gorillamux.NewRouter(doc)
+	})
+	if specErr != nil {
+		t.Fatalf("openapi.yml: %s", specErr)
+	}
+	return specRouter
+}
+
+type testServer struct {
+	app      *fiber.App
+	services *service.Services
+	router   routers.Router
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	cfg := &config.Config{Auth: config.Auth{JWTKey: "test-key", TokenTTL: time.Hour}}
+	app, services := newServer(cfg, newTestRepositories(t))
+	return &testServer{
+		app:      app,
+		services: services,
+		router:   loadSpec(t),
+	}
+}
+
+func newTestRepositories(t *testing.T) *repository.Repositories {
+	t.Helper()
+	conn := os.Getenv("TEST_POSTGRES_CONN")
+	if conn == "" {
+		return memory.NewRepositories()
+	}
+
+	ctx := context.Background()
+	admin, err := pgx.Connect(ctx, conn)
+	if err != nil {
+		t.Fatalf("connect to TEST_POSTGRES_CONN: %s", err)
+	}
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	_, err = admin.Exec(ctx, "CREATE SCHEMA "+schema)
+	if err != nil {
+		t.Fatalf("create schema: %s", err)
+	}
+	t.Cleanup(func() {
+		_, _ = admin.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE")
+		_ = admin.Close(ctx)
+	})
+
+	// public остается в search_path ради функций расширения uuid-ossp, если оно уже установлено
+	u, err := url.Parse(conn)
+	if err != nil {
+		t.Fatalf("TEST_POSTGRES_CONN must be a URL: %s", err)
+	}
+	query := u.Query()
+	query.Set("search_path", schema+",public")
+	u.RawQuery = query.Encode()
+
+	db := postgres.New(u.String())
+	t.Cleanup(db.Close)
+	migrator, err := migrate.New(db.Pool, migrations.FS)
+	if err != nil {
+		t.Fatalf("load migrations: %s", err)
+	}
+	_, err = migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("apply migrations: %s", err)
+	}
+	return repository.NewRepositories(db)
+}
+
+// anonymous возвращает клиента без токена.
+func (s *testServer) anonymous() *apiClient {
+	return &apiClient{server: s}
+}
+
+// signUp регистрирует сотрудника и возвращает клиента с его токеном.
+func (s *testServer) signUp(t *testing.T, username string) *apiClient {
+	t.Helper()
+	anonymous := s.anonymous()
+	anonymous.do(t, http.MethodPost, "/api/employees/new", map[string]string{
+		"username":  username,
+		"firstName": "Test",
+		"lastName":  "User",
+		"password":  testPassword,
+	}).expect(http.StatusOK)
+
+	var token struct {
+		Token string `json:"token"`
+	}
+	anonymous.do(t, http.MethodPost, "/api/auth/login", map[string]string{
+		"username": username,
+		"password": testPassword,
+	}).expect(http.StatusOK).decode(&token)
+	return &apiClient{server: s, username: username, token: token.Token}
+}
+
+type apiClient struct {
+	server   *testServer
+	username string
+	token    string
+}
+
+type apiResponse struct {
+	t      *testing.T
+	target string
+	status int
+	header http.Header
+	body   []byte
+}
+
+// do выполняет запрос от имени клиента. headers задаются парами имя-значение.
+func (c *apiClient) do(t *testing.T, method, target string, body interface{}, headers ...string) *apiResponse {
+	t.Helper()
+
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			t.Fatalf("%s %s: marshal body: %s", method, target, err)
+		}
+	}
+	newRequest := func(url string) *http.Request {
+		req := httptest.NewRequest(method, url, bytes.NewReader(payload))
+		if body != nil {
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		}
+		if c.token != "" {
+			req.Header.Set(fiber.HeaderAuthorization, "Bearer "+c.token)
+		}
+		for i := 0; i+1 < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		return req
+	}
+
+	requestInput, requestErr := c.validateRequest(t, newRequest(specServer+target))
+
+	resp, err := c.server.app.Test(newRequest(target), -1)
+	if err != nil {
+		t.Fatalf("%s %s: %s", method, target, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("%s %s: read body: %s", method, target, err)
+	}
+
+	err = openapi3filter.ValidateResponse(context.Background(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: requestInput,
+		Status:                 resp.StatusCode,
+		Header:                 resp.Header,
+		Body:                   io.NopCloser(bytes.NewReader(respBody)),
+		Options:                &openapi3filter.Options{IncludeResponseStatus: true},
+	})
+	if err != nil {
+		t.Fatalf("%s %s: response %d %s does not match openapi.yml: %s",
+			method, target, resp.StatusCode, respBody, err)
+	}
+	if requestErr != nil && resp.StatusCode < http.StatusBadRequest {
+		t.Fatalf("%s %s: server accepted a request that violates openapi.yml: %s", method, target, requestErr)
+	}
+	return &apiResponse{
+		t:      t,
+		target: method + " " + target,
+		status: resp.StatusCode,
+		header: resp.Header,
+		body:   respBody,
+	}
+}
+
+// validateRequest находит операцию запроса в контракте и возвращает ошибку, если запрос ему не соответствует.
+func (c *apiClient) validateRequest(
+	t *testing.T,
+	req *http.Request,
+) (*openapi3filter.RequestValidationInput, error) {
+	t.Helper()
+
+	route, pathParams, err := c.server.router.FindRoute(req)
+	if err != nil {
+		t.Fatalf("%s %s is not described in openapi.yml: %s", req.Method, req.URL.Path, err)
+	}
+	input := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    &openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc},
+	}
+	return input, openapi3filter.ValidateRequest(context.Background(), input)
+}
+
+func (r *apiResponse) expect(status int) *apiResponse {
+	r.t.Helper()
+	if r.status != status {
+		r.t.Fatalf("%s: got status %d, want %d, body %s", r.target, r.status, status, r.body)
+	}
+	return r
+}
+
+func (r *apiResponse) decode(v interface{}) {
+	r.t.Helper()
+	err := json.Unmarshal(r.body, v)
+	if err != nil {
+		r.t.Fatalf("%s: decode %s: %s", r.target, r.body, err)
+	}
+}
+
+// version возвращает номер версии из заголовка ETag.
+func (r *apiResponse) version() int {
+	r.t.Helper()
+	version, err := strconv.Unquote(r.header.Get(fiber.HeaderETag))
+	if err == nil {
+		var v int
+		v, err = strconv.Atoi(version)
+		if err == nil {
+			return v
+		}
+	}
+	r.t.Fatalf("%s: invalid ETag %q", r.target, r.header.Get(fiber.HeaderETag))
+	return 0
+}
+
+type organizationDTO struct {
+	ID string `json:"id"`
+}
+
+type tenderDTO struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	ServiceType    string    `json:"serviceType"`
+	Status         string    `json:"status"`
+	OrganizationID string    `json:"organizationId"`
+	Version        int       `json:"version"`
+	CreatedAt      time.Time `json:"createdAt"`
+
+	SubmissionDeadline *time.Time `json:"submissionDeadline"`
+	ClosedBy           string     `json:"closedBy"`
+	PublishAt          *time.Time `json:"publishAt"`
+}
+
+type publicationDTO struct {
+	TenderID  string    `json:"tenderId"`
+	PublishAt time.Time `json:"publishAt"`
+	Status    string    `json:"status"`
+	CreatedBy string    `json:"createdBy"`
+	Error     string    `json:"error"`
+}
+
+type bidDTO struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	TenderID    string `json:"tenderId"`
+	AuthorType  string `json:"authorType"`
+	AuthorID    string `json:"authorId"`
+	Version     int    `json:"version"`
+}
+
+type errorDTO struct {
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+	Errors []struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// fields возвращает имена полей, перечисленных в ошибке проверки запроса.
+func (e errorDTO) fields() []string {
+	fields := make([]string, 0, len(e.Errors))
+	for _, f := range e.Errors {
+		fields = append(fields, f.Field)
+	}
+	return fields
+}
+
+func (c *apiClient) createOrganization(t *testing.T, name string) string {
+	t.Helper()
+	var org organizationDTO
+	c.do(t, http.MethodPost, "/api/organizations/new", map[string]string{
+		"name":        name,
+		"description": "Организация " + name,
+		"type":        "LLC",
+	}).expect(http.StatusOK).decode(&org)
+	return org.ID
+}
+
+func (c *apiClient) addResponsible(t *testing.T, organizationId, username, role string) {
+	t.Helper()
+	c.do(t, http.MethodPost,
+		"/api/organizations/"+organizationId+"/responsibles/"+username+"?role="+url.QueryEscape(role),
+		nil).expect(http.StatusNoContent)
+}
+
+func (c *apiClient) createTender(t *testing.T, organizationId, name, serviceType string) tenderDTO {
+	t.Helper()
+	var tender tenderDTO
+	c.do(t, http.MethodPost, "/api/tenders/new", map[string]string{
+		"name":           name,
+		"description":    "Описание тендера",
+		"serviceType":    serviceType,
+		"organizationId": organizationId,
+	}).expect(http.StatusOK).decode(&tender)
+	return tender
+}
+
+func (c *apiClient) setTenderStatus(t *testing.T, tenderId, status string) tenderDTO {
+	t.Helper()
+	var tender tenderDTO
+	c.do(t, http.MethodPut, "/api/tenders/"+tenderId+"/status?status="+status, nil).
+		expect(http.StatusOK).decode(&tender)
+	return tender
+}
+
+func (c *apiClient) createBid(t *testing.T, tenderId, organizationId, name string) bidDTO {
+	t.Helper()
+	var bid bidDTO
+	c.do(t, http.MethodPost, "/api/bids/new", map[string]string{
+		"name":           name,
+		"description":    "Описание предложения",
+		"tenderId":       tenderId,
+		"organizationId": organizationId,
+	}).expect(http.StatusOK).decode(&bid)
+	return bid
+}
+
+func (c *apiClient) setBidStatus(t *testing.T, bidId, status string) bidDTO {
+	t.Helper()
+	var bid bidDTO
+	c.do(t, http.MethodPut, "/api/bids/"+bidId+"/status?status="+status, nil).
+		expect(http.StatusOK).decode(&bid)
+	return bid
+}
+
+func TestPing(t *testing.T) {
+	s := newTestServer(t)
+
+	resp := s.anonymous().do(t, http.MethodGet, "/api/ping", nil).expect(http.StatusOK)
+	if string(resp.body) != "ok" {
+		t.Fatalf("ping body %q, want ok", resp.body)
+	}
+}
+
+func TestErrorsHaveReason(t *testing.T) {
+	s := newTestServer(t)
+
+	var e errorDTO
+	s.anonymous().do(t, http.MethodPost, "/api/tenders/new", map[string]string{
+		"name":           "Тендер",
+		"description":    "Описание",
+		"serviceType":    "Construction",
+		"organizationId": "550e8400-e29b-41d4-a716-446655440000",
+	}).expect(http.StatusUnauthorized).decode(&e)
+	if e.Reason == "" {
+		t.Fatal("error response without reason")
+	}
+
+	// Закрытые операции берутся из security в openapi.yml
+	s.anonymous().do(t, http.MethodGet, "/api/bids/my", nil).expect(http.StatusUnauthorized)
+
+	// Ошибки разбора параметров из сгенерированного роутера тоже отдаются с reason
+	e = errorDTO{}
+	s.anonymous().do(t, http.MethodGet, "/api/tenders/not-a-uuid/status", nil).
+		expect(http.StatusBadRequest).decode(&e)
+	if e.Reason == "" {
+		t.Fatal("parameter error without reason")
+	}
+
+	s.anonymous().do(t, http.MethodPost, "/api/auth/login", map[string]string{
+		"username": "nobody",
+		"password": testPassword,
+	}).expect(http.StatusUnauthorized)
+}
+
+// problem проверяет, что ответ — application/problem+json с ожидаемым кодом ошибки.
+func (r *apiResponse) problem(code string) {
+	r.t.Helper()
+	if contentType := r.header.Get(fiber.HeaderContentType); !strings.HasPrefix(contentType, "application/problem+json") {
+		r.t.Fatalf("%s: content type %q, want application/problem+json", r.target, contentType)
+	}
+	var e errorDTO
+	r.decode(&e)
+	if e.Code != code || e.Status != r.status || e.Reason == "" {
+		r.t.Fatalf("%s: problem %s, want code %s and status %d", r.target, r.body, code, r.status)
+	}
+}
+
+func TestTokenOfDeletedEmployee(t *testing.T) {
+	s := newTestServer(t)
+	old := s.signUp(t, "token_user")
+	old.do(t, http.MethodDelete, "/api/employees/token_user", nil).expect(http.StatusNoContent)
+
+	// Новый сотрудник с тем же именем не наследует токены удаленного
+	s.signUp(t, "token_user")
+	old.do(t, http.MethodGet, "/api/tenders/my", nil).
+		expect(http.StatusUnauthorized).problem("invalid_token")
+}
+
+func TestErrorsAreProblemDetails(t *testing.T) {
+	s := newTestServer(t)
+	user1 := s.signUp(t, "problem_user1")
+	user2 := s.signUp(t, "problem_user2")
+	org := user1.createOrganization(t, "Проблемы")
+	tender := user1.createTender(t, org, "Тендер", "Construction")
+
+	s.anonymous().do(t, http.MethodGet, "/api/tenders/my", nil).
+		expect(http.StatusUnauthorized).problem("unauthorized")
+	s.anonymous().do(t, http.MethodGet, "/api/tenders/my", nil, fiber.HeaderAuthorization, "Bearer broken").
+		expect(http.StatusUnauthorized).problem("invalid_token")
+	user2.do(t, http.MethodGet, "/api/tenders/"+tender.ID+"/status", nil).
+		expect(http.StatusForbidden).problem("access_denied")
+	user1.do(t, http.MethodGet, "/api/tenders/"+uuid.NewString()+"/status", nil).
+		expect(http.StatusNotFound).problem("tender_not_found")
+	user1.do(t, http.MethodPut, "/api/tenders/"+tender.ID+"/rollback/42", nil).
+		expect(http.StatusNotFound).problem("version_not_found")
+	s.anonymous().do(t, http.MethodGet, "/api/employees/nobody", nil).
+		expect(http.StatusNotFound).problem("employee_not_found")
+	// Неизвестный сотрудник, над которым выполняется действие, — это 404, а не ошибка аутентификации автора
+	user1.do(t, http.MethodPost, "/api/organizations/"+org+"/responsibles/nobody", nil).
+		expect(http.StatusNotFound).problem("employee_not_found")
+	s.anonymous().do(t, http.MethodGet, "/api/tenders?limit=51", nil).
+		expect(http.StatusBadRequest).problem("validation_failed")
+}
+
+func TestErrorsAreLocalized(t *testing.T) {
+	s := newTestServer(t)
+	user := s.signUp(t, "locale_user")
+	missing := "/api/tenders/" + uuid.NewString() + "/status"
+
+	cases := []struct {
+		acceptLanguage string
+		lang           string
+		title          string
+		reason         string
+	}{
+		{"", "ru", "Не найдено", "тендер не найден"},
+		{"en-US,en;q=0.9", "en", "Not Found", "tender not found"},
+		{"de-DE, en;q=0.5", "en", "Not Found", "tender not found"},
+		{"fr", "ru", "Не найдено", "тендер не найден"},
+	}
+	for _, c := range cases {
+		var e errorDTO
+		resp := user.do(t, http.MethodGet, missing, nil, fiber.HeaderAcceptLanguage, c.acceptLanguage).
+			expect(http.StatusNotFound)
+		resp.decode(&e)
+		if e.Reason != c.reason || resp.header.Get(fiber.HeaderContentLanguage) != c.lang {
+			t.Fatalf("Accept-Language %q: reason %q in %q, want %q in %q", c.acceptLanguage,
+				e.Reason, resp.header.Get(fiber.HeaderContentLanguage), c.reason, c.lang)
+		}
+		if e.Title != c.title {
+			t.Fatalf("Accept-Language %q: title %q, want %q", c.acceptLanguage, e.Title, c.title)
+		}
+	}
+
+	var e errorDTO
+	s.anonymous().do(t, http.MethodGet, "/api/tenders?limit=51", nil, fiber.HeaderAcceptLanguage, "en").
+		expect(http.StatusBadRequest).decode(&e)
+	if len(e.Errors) != 1 || e.Errors[0].Message != "value must be at most 50" {
+		t.Fatalf("validation errors %+v, want an English message for limit", e.Errors)
+	}
+}
+
+func TestValidationReportsEveryField(t *testing.T) {
+	s := newTestServer(t)
+	user := s.signUp(t, "validation_user")
+	organizationId := user.createOrganization(t, "Валидация")
+
+	var e errorDTO
+	user.do(t, http.MethodPost, "/api/tenders/new", map[string]string{
+		"name":           strings.Repeat("т", 101),
+		"description":    "Описание",
+		"serviceType":    "Cleaning",
+		"organizationId": organizationId,
+	}).expect(http.StatusBadRequest).decode(&e)
+	if e.Reason == "" {
+		t.Fatal("validation error without reason")
+	}
+	fields := strings.Join(e.fields(), ",")
+	if fields != "name,serviceType" && fields != "serviceType,name" {
+		t.Fatalf("invalid fields %v, want name and serviceType", e.fields())
+	}
+
+	e = errorDTO{}
+	s.anonymous().do(t, http.MethodGet, "/api/tenders?limit=51&offset=-1&service_type=Cleaning", nil).
+		expect(http.StatusBadRequest).decode(&e)
+	if len(e.Errors) != 3 {
+		t.Fatalf("invalid fields %v, want limit, offset and service_type", e.fields())
+	}
+}
@@ -1,10 +1,17 @@
 package app
 
 import (
+	"context"
+	"time"
 	"zadanie-6105/config"
+	"zadanie-6105/internal/auth"
 	"zadanie-6105/internal/controller"
 	"zadanie-6105/internal/repository"
+	"zadanie-6105/internal/repository/memory"
+	"zadanie-6105/internal/scheduler"
 	"zadanie-6105/internal/service"
+	"zadanie-6105/migrations"
+	"zadanie-6105/pkg/migrate"
 	"zadanie-6105/pkg/postgres"
 	"zadanie-6105/slogger"
 
@@ -13,33 +20,119 @@ import (
 	"github.com/gookit/slog"
 )
 
+// devJWTKey подписывает токены, только если включен AUTH_DEV_MODE и JWT_KEY не задан. Ключ известен всем,
+// но в этом режиме любой и так может представиться кем угодно через ?username=.
+const devJWTKey = "dev-only-jwt-key"
+
 func Run() {
 	slogger.SetLogger()
 
 	slog.Info("init config")
 	cfg := config.NewConfig()
+	if cfg.JWTKey == "" {
+		if !cfg.DevMode {
+			slog.Fatal("JWT_KEY must be set unless AUTH_DEV_MODE is enabled")
+		}
+		slog.Warn("JWT_KEY is not set, signing tokens with the development key")
+		cfg.JWTKey = devJWTKey
+	}
+	err := cfg.Scheduler.Validate()
+	if err != nil {
+		slog.Fatalf("invalid scheduler config %s", err.Error())
+	}
 	slog.Info("config ok")
 
-	slog.Info("connecting to postgres")
-	db := postgres.New(cfg.PostgresConn)
-	defer db.Close()
-	slog.Info("connect to postgres ok")
+	var repositories *repository.Repositories
+	switch cfg.Backend {
+	case config.StorageMemory:
+		slog.Info("init in-memory repositories, data will be lost on restart")
+		repositories = memory.NewRepositories()
+	case config.StoragePostgres:
+		db := connectPostgres(cfg)
+		defer db.Close()
+
+		slog.Info("init repositories")
+		repositories = repository.NewRepositories(db)
+	default:
+		slog.Fatalf("unknown STORAGE %q, expected %s or %s", cfg.Backend, config.StoragePostgres, config.StorageMemory)
+	}
+
+	app, services := newServer(cfg, repositories)
 
-	slog.Info("init repositories")
-	repositories := repository.NewRepositories(db)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	startScheduler(ctx, cfg, services)
 
+	slog.Info("starting fiber server")
+	slog.Fatal(app.Listen(cfg.ServerAddress))
+}
+
+// newServer собирает сервисы и HTTP-обработчики поверх готовых репозиториев.
+func newServer(cfg *config.Config, repositories *repository.Repositories) (*fiber.App, *service.Services) {
 	slog.Info("init services")
 	deps := service.ServicesDeps{
-		Repository: repositories,
+		Repository:   repositories,
+		TokenManager: auth.NewTokenManager(cfg.JWTKey, cfg.TokenTTL),
 	}
 
 	services := service.NewServices(deps)
 
-	fiberConfig := fiber.Config{}
+	// Без Immutable строки из запроса ссылаются на переиспользуемые буферы fasthttp,
+	// а хранилище в памяти сохраняет их как есть
+	fiberConfig := fiber.Config{Immutable: true, ErrorHandler: controller.ErrorHandler}
 	app := fiber.New(fiberConfig)
 
-	controller.NewRouter(app, services)
+	err := controller.NewRouter(app, services, cfg.DevMode)
+	if err != nil {
+		slog.Fatalf("can't init router %s", err.Error())
+	}
+	return app, services
+}
 
-	slog.Info("starting fiber server")
-	slog.Fatal(app.Listen(cfg.ServerAddress))
+// startScheduler запускает фоновые задачи сервиса; они останавливаются вместе с ctx.
+func startScheduler(ctx context.Context, cfg *config.Config, services *service.Services) {
+	slog.Info("starting scheduler")
+	go every(ctx, "close expired tenders", cfg.DeadlineCheckInterval, func(ctx context.Context) error {
+		closed, err := services.ITender.CloseExpired(ctx, time.Now())
+		if closed > 0 {
+			slog.Infof("closed %d tenders with expired submission deadline", closed)
+		}
+		return err
+	})
+	go every(ctx, "publish scheduled tenders", cfg.PublishCheckInterval, func(ctx context.Context) error {
+		published, err := services.ITender.PublishDue(ctx, time.Now())
+		if published > 0 {
+			slog.Infof("published %d scheduled tenders", published)
+		}
+		return err
+	})
+}
+
+// every запускает задачу по расписанию и пишет в лог, если расписание не удалось запустить.
+func every(ctx context.Context, name string, interval time.Duration, job scheduler.Job) {
+	err := scheduler.Every(ctx, name, interval, job)
+	if err != nil {
+		slog.Errorf("internal.app.every, error: {%s}", err.Error())
+	}
+}
+
+// connectPostgres подключается к базе и, если включено, применяет недостающие миграции.
+func connectPostgres(cfg *config.Config) *postgres.DB {
+	slog.Info("connecting to postgres")
+	db := postgres.New(cfg.PostgresConn)
+	slog.Info("connect to postgres ok")
+
+	if cfg.MigrateOnStart {
+		slog.Info("applying migrations")
+		migrator, err := migrate.New(db.Pool, migrations.FS)
+		if err != nil {
+			slog.Fatalf("can't load migrations %s", err.Error())
+		}
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			slog.Fatalf("can't apply migrations %s", err.Error())
+		}
+		slog.Infof("migrations ok, applied %d", len(applied))
+	}
+	return db
 }
@@ -0,0 +1,288 @@
+package app
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestBids(t *testing.T) {
+	s := newTestServer(t)
+	user1 := s.signUp(t, "user1")
+	tenderOrgId := user1.createOrganization(t, "Заказчик")
+	tender := user1.createTender(t, tenderOrgId, "Тендер 1", "Construction")
+	user1.setTenderStatus(t, tender.ID, "Published")
+
+	user2 := s.signUp(t, "user2")
+	bidOrgId := user2.createOrganization(t, "Подрядчик")
+
+	var bid bidDTO
+	t.Run("create", func(t *testing.T) {
+		resp := user2.do(t, http.MethodPost, "/api/bids/new", map[string]string{
+			"name":           "Предложение 1",
+			"description":    "Описание предложения",
+			"tenderId":       tender.ID,
+			"organizationId": bidOrgId,
+		}).expect(http.StatusOK)
+		resp.decode(&bid)
+
+		if bid.Name != "Предложение 1" || bid.Status != "Created" || bid.TenderID != tender.ID {
+			t.Fatalf("unexpected bid %+v", bid)
+		}
+		if bid.AuthorType != "Organization" || bid.AuthorID != bidOrgId {
+			t.Fatalf("bid author %s %s, want organization %s", bid.AuthorType, bid.AuthorID, bidOrgId)
+		}
+		if bid.Version != 1 || resp.version() != 1 {
+			t.Fatalf("new bid version %d, ETag %d, want 1", bid.Version, resp.version())
+		}
+	})
+
+	t.Run("create on unpublished tender", func(t *testing.T) {
+		draft := user1.createTender(t, tenderOrgId, "Черновик", "Delivery")
+		user2.do(t, http.MethodPost, "/api/bids/new", map[string]string{
+			"name":           "Предложение",
+			"description":    "Описание",
+			"tenderId":       draft.ID,
+			"organizationId": bidOrgId,
+		}).expect(http.StatusNotFound)
+	})
+
+	t.Run("my", func(t *testing.T) {
+		var bids []bidDTO
+		user2.do(t, http.MethodGet, "/api/bids/my", nil).expect(http.StatusOK).decode(&bids)
+		if len(bids) != 1 || bids[0].ID != bid.ID {
+			t.Fatalf("my bids %+v", bids)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		var bids []bidDTO
+		user2.do(t, http.MethodGet, "/api/bids/"+tender.ID+"/list", nil).expect(http.StatusOK).decode(&bids)
+		if len(bids) != 1 {
+			t.Fatalf("author sees %d bids, want 1", len(bids))
+		}
+
+		// Организация тендера видит предложение только после публикации, а до нее получает пустой список
+		user1.do(t, http.MethodGet, "/api/bids/"+tender.ID+"/list", nil).expect(http.StatusOK).decode(&bids)
+		if len(bids) != 0 {
+			t.Fatalf("tender organization sees %d unpublished bids, want 0", len(bids))
+		}
+		bid = user2.setBidStatus(t, bid.ID, "Published")
+		if bid.Status != "Published" || bid.Version != 2 {
+			t.Fatalf("after publish %+v", bid)
+		}
+		user1.do(t, http.MethodGet, "/api/bids/"+tender.ID+"/list", nil).expect(http.StatusOK).decode(&bids)
+		if len(bids) != 1 || bids[0].ID != bid.ID {
+			t.Fatalf("tender organization sees %+v", bids)
+		}
+	})
+
+	t.Run("status", func(t *testing.T) {
+		var status string
+		user2.do(t, http.MethodGet, "/api/bids/"+bid.ID+"/status", nil).expect(http.StatusOK).decode(&status)
+		if status != "Published" {
+			t.Fatalf("status %q, want Published", status)
+		}
+		s.signUp(t, "stranger").do(t, http.MethodGet, "/api/bids/"+bid.ID+"/status", nil).
+			expect(http.StatusForbidden)
+	})
+
+	t.Run("edit", func(t *testing.T) {
+		resp := user2.do(t, http.MethodPatch, "/api/bids/"+bid.ID+"/edit", map[string]string{
+			"name":        "Обновленное Предложение 1",
+			"description": "Обновленное описание",
+		}).expect(http.StatusOK)
+		resp.decode(&bid)
+
+		if bid.Name != "Обновленное Предложение 1" || bid.Description != "Обновленное описание" {
+			t.Fatalf("unexpected bid after edit %+v", bid)
+		}
+		if bid.Version != 3 || resp.version() != 3 {
+			t.Fatalf("version after edit %d, ETag %d, want 3", bid.Version, resp.version())
+		}
+
+		user2.do(t, http.MethodPatch, "/api/bids/"+bid.ID+"/edit", map[string]string{"name": "Устаревшая"},
+			"If-Match", strconv.Quote("2")).expect(http.StatusPreconditionFailed)
+	})
+
+	t.Run("versions", func(t *testing.T) {
+		var versions []bidDTO
+		user2.do(t, http.MethodGet, "/api/bids/"+bid.ID+"/versions", nil).expect(http.StatusOK).decode(&versions)
+		if len(versions) != 3 || versions[0].Version != 3 {
+			t.Fatalf("versions %+v", versions)
+		}
+
+		// Страница за последней версией существующего предложения пуста, а не 404
+		versions = nil
+		user2.do(t, http.MethodGet, "/api/bids/"+bid.ID+"/versions?offset=10", nil).
+			expect(http.StatusOK).decode(&versions)
+		if versions == nil || len(versions) != 0 {
+			t.Fatalf("versions past the end %+v, want []", versions)
+		}
+		user2.do(t, http.MethodGet, "/api/bids/"+uuid.NewString()+"/versions", nil).
+			expect(http.StatusNotFound).problem("bid_not_found")
+
+		var first bidDTO
+		user2.do(t, http.MethodGet, "/api/bids/"+bid.ID+"/versions/1", nil).expect(http.StatusOK).decode(&first)
+		if first.Name != "Предложение 1" || first.Status != "Created" {
+			t.Fatalf("version 1 %+v", first)
+		}
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		resp := user2.do(t, http.MethodPut, "/api/bids/"+bid.ID+"/rollback/2", nil,
+			"If-Match", strconv.Quote(strconv.Itoa(bid.Version))).expect(http.StatusOK)
+		resp.decode(&bid)
+
+		if bid.Name != "Предложение 1" || bid.Description != "Описание предложения" {
+			t.Fatalf("rollback did not restore version 2: %+v", bid)
+		}
+		if bid.Status != "Published" || bid.Version != 4 {
+			t.Fatalf("rollback must keep status and bump version: %+v", bid)
+		}
+
+		user2.do(t, http.MethodPut, "/api/bids/"+bid.ID+"/rollback/42", nil).expect(http.StatusNotFound)
+	})
+
+	t.Run("rollback by author without manage role", func(t *testing.T) {
+		author := s.signUp(t, "bid_author")
+		user2.addResponsible(t, bidOrgId, author.username, "bid_author")
+		own := author.createBid(t, tender.ID, bidOrgId, "Предложение автора")
+		author.do(t, http.MethodPatch, "/api/bids/"+own.ID+"/edit", map[string]string{"name": "Правка автора"}).
+			expect(http.StatusOK)
+		user2.do(t, http.MethodPut, "/api/organizations/"+bidOrgId+"/responsibles/"+author.username+"?role=viewer",
+			nil).expect(http.StatusNoContent)
+
+		// Автор откатывает свое предложение и без права управлять предложениями организации, чужое — нет
+		var restored bidDTO
+		author.do(t, http.MethodPut, "/api/bids/"+own.ID+"/rollback/1", nil).expect(http.StatusOK).decode(&restored)
+		if restored.Name != "Предложение автора" || restored.Version != 3 {
+			t.Fatalf("author rollback %+v", restored)
+		}
+		author.do(t, http.MethodPut, "/api/bids/"+bid.ID+"/rollback/1", nil).
+			expect(http.StatusForbidden).problem("access_denied")
+	})
+
+	t.Run("decision on unpublished bid", func(t *testing.T) {
+		draft := user2.createBid(t, tender.ID, bidOrgId, "Черновик")
+		user1.do(t, http.MethodPut, "/api/bids/"+draft.ID+"/submit_decision?decision=Approved", nil).
+			expect(http.StatusBadRequest).problem("bid_not_published")
+
+		canceled := user2.createBid(t, tender.ID, bidOrgId, "Отозванное")
+		user2.setBidStatus(t, canceled.ID, "Published")
+		user2.setBidStatus(t, canceled.ID, "Canceled")
+		user1.do(t, http.MethodPut, "/api/bids/"+canceled.ID+"/submit_decision?decision=Approved", nil).
+			expect(http.StatusBadRequest).problem("bid_not_published")
+
+		var status string
+		user1.do(t, http.MethodGet, "/api/tenders/"+tender.ID+"/status", nil).expect(http.StatusOK).decode(&status)
+		if status != "Published" {
+			t.Fatalf("decision on unpublished bid changed tender status to %q", status)
+		}
+	})
+
+	t.Run("decision", func(t *testing.T) {
+		// С двумя рецензентами для согласования нужны оба голоса
+		reviewer := s.signUp(t, "reviewer")
+		user1.addResponsible(t, tenderOrgId, reviewer.username, "reviewer")
+
+		user2.do(t, http.MethodPut, "/api/bids/"+bid.ID+"/submit_decision?decision=Approved", nil).
+			expect(http.StatusForbidden)
+
+		var decided bidDTO
+		resp := user1.do(t, http.MethodPut, "/api/bids/"+bid.ID+"/submit_decision?decision=Approved", nil).
+			expect(http.StatusOK)
+		resp.decode(&decided)
+		if resp.version() != decided.Version {
+			t.Fatalf("ETag after decision %d, want version %d", resp.version(), decided.Version)
+		}
+		user1.do(t, http.MethodPut, "/api/bids/"+bid.ID+"/submit_decision?decision=Rejected", nil).
+			expect(http.StatusConflict).problem("decision_already_made")
+		var status string
+		user1.do(t, http.MethodGet, "/api/tenders/"+tender.ID+"/status", nil).expect(http.StatusOK).decode(&status)
+		if status != "Published" {
+			t.Fatalf("tender closed before quorum, status %q", status)
+		}
+
+		// Решение по кворуму меняет предложение, и клиент получает новый ETag
+		resp = reviewer.do(t, http.MethodPut, "/api/bids/"+bid.ID+"/submit_decision?decision=Approved", nil).
+			expect(http.StatusOK)
+		resp.decode(&decided)
+		if decided.Version <= bid.Version || resp.version() != decided.Version {
+			t.Fatalf("version after quorum %d, ETag %d, was %d", decided.Version, resp.version(), bid.Version)
+		}
+		user1.do(t, http.MethodGet, "/api/tenders/"+tender.ID+"/status", nil).expect(http.StatusOK).decode(&status)
+		if status != "Closed" {
+			t.Fatalf("approved bid must close the tender, status %q", status)
+		}
+		var tenders []tenderDTO
+		user1.do(t, http.MethodGet, "/api/tenders/my", nil).expect(http.StatusOK).decode(&tenders)
+		for _, closed := range tenders {
+			if closed.ID == tender.ID && closed.ClosedBy != "award" {
+				t.Fatalf("tender closed by approved bid has closedBy %q, want award", closed.ClosedBy)
+			}
+		}
+
+		var decisions []struct {
+			Username string `json:"username"`
+			Decision string `json:"decision"`
+		}
+		user2.do(t, http.MethodGet, "/api/bids/"+bid.ID+"/decisions", nil).expect(http.StatusOK).decode(&decisions)
+		if len(decisions) != 2 || decisions[0].Username != "user1" || decisions[1].Username != "reviewer" {
+			t.Fatalf("decisions %+v", decisions)
+		}
+	})
+}
+
+func TestBidReviews(t *testing.T) {
+	s := newTestServer(t)
+	user1 := s.signUp(t, "user1")
+	tenderOrgId := user1.createOrganization(t, "Заказчик")
+	tender := user1.createTender(t, tenderOrgId, "Тендер 1", "Delivery")
+	user1.setTenderStatus(t, tender.ID, "Published")
+
+	user2 := s.signUp(t, "user2")
+	bidOrgId := user2.createOrganization(t, "Подрядчик")
+	bid := user2.createBid(t, tender.ID, bidOrgId, "Предложение 1")
+	user2.setBidStatus(t, bid.ID, "Published")
+
+	t.Run("feedback", func(t *testing.T) {
+		var res bidDTO
+		user1.do(t, http.MethodPut, "/api/bids/"+bid.ID+"/feedback?bidFeedback=Отличное+предложение", nil).
+			expect(http.StatusOK).decode(&res)
+		if res.ID != bid.ID {
+			t.Fatalf("feedback returned bid %+v", res)
+		}
+
+		user2.do(t, http.MethodPut, "/api/bids/"+bid.ID+"/feedback?bidFeedback=Сам+себя+хвалю", nil).
+			expect(http.StatusForbidden)
+	})
+
+	t.Run("feedback on draft", func(t *testing.T) {
+		draft := user2.createBid(t, tender.ID, bidOrgId, "Черновик")
+		user1.do(t, http.MethodGet, "/api/bids/"+draft.ID+"/status", nil).expect(http.StatusForbidden)
+		user1.do(t, http.MethodPut, "/api/bids/"+draft.ID+"/feedback?bidFeedback=Рано", nil).
+			expect(http.StatusForbidden).problem("access_denied")
+	})
+
+	t.Run("reviews", func(t *testing.T) {
+		var reviews []struct {
+			ID          string `json:"id"`
+			Description string `json:"description"`
+		}
+		user1.do(t, http.MethodGet, "/api/bids/"+tender.ID+"/reviews?authorUsername=user2", nil).
+			expect(http.StatusOK).decode(&reviews)
+		if len(reviews) != 1 || reviews[0].Description != "Отличное предложение" {
+			t.Fatalf("reviews %+v", reviews)
+		}
+
+		user2.do(t, http.MethodGet, "/api/bids/"+tender.ID+"/reviews?authorUsername=user2", nil).
+			expect(http.StatusForbidden)
+		user1.do(t, http.MethodGet, "/api/bids/"+tender.ID+"/reviews?authorUsername=user1", nil).
+			expect(http.StatusNotFound)
+		user1.do(t, http.MethodGet, "/api/bids/"+tender.ID+"/reviews?authorUsername=nobody", nil).
+			expect(http.StatusNotFound).problem("employee_not_found")
+	})
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"zadanie-6105/config"
+	"zadanie-6105/migrations"
+	"zadanie-6105/pkg/migrate"
+	"zadanie-6105/pkg/postgres"
+	"zadanie-6105/slogger"
+
+	"github.com/gookit/slog"
+)
+
+const migrateUsage = "usage: migrate up | down [steps] | status"
+
+// Migrate выполняет подкоманду migrate: up применяет все новые миграции,
+// down откатывает последние (по умолчанию одну), status печатает состояние каждой миграции.
+func Migrate(args []string) {
+	slogger.SetLogger()
+
+	if len(args) == 0 {
+		slog.Fatal(migrateUsage)
+	}
+
+	cfg := config.NewConfig()
+	db := postgres.New(cfg.PostgresConn)
+	defer db.Close()
+
+	migrator, err := migrate.New(db.Pool, migrations.FS)
+	if err != nil {
+		slog.Fatalf("can't load migrations %s", err.Error())
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			slog.Fatalf("migrate up failed %s", err.Error())
+		}
+		for _, m := range applied {
+			fmt.Printf("applied %04d_%s\n", m.Version, m.Name)
+		}
+		fmt.Printf("%d migration(s) applied\n", len(applied))
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				slog.Fatal(migrateUsage)
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		if err != nil {
+			slog.Fatalf("migrate down failed %s", err.Error())
+		}
+		for _, m := range reverted {
+			fmt.Printf("reverted %04d_%s\n", m.Version, m.Name)
+		}
+		fmt.Printf("%d migration(s) reverted\n", len(reverted))
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			slog.Fatalf("migrate status failed %s", err.Error())
+		}
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-40s %s\n", s.Version, s.Name, appliedAt)
+		}
+	default:
+		slog.Fatal(migrateUsage)
+	}
+}
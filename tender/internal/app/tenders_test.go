@@ -0,0 +1,535 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTenders(t *testing.T) {
+	s := newTestServer(t)
+	user1 := s.signUp(t, "user1")
+	orgId := user1.createOrganization(t, "Org 1")
+
+	var tender tenderDTO
+	t.Run("create", func(t *testing.T) {
+		resp := user1.do(t, http.MethodPost, "/api/tenders/new", map[string]string{
+			"name":           "Тендер 1",
+			"description":    "Описание тендера",
+			"serviceType":    "Construction",
+			"organizationId": orgId,
+		}).expect(http.StatusOK)
+		resp.decode(&tender)
+
+		if tender.Name != "Тендер 1" || tender.Status != "Created" || tender.OrganizationID != orgId {
+			t.Fatalf("unexpected tender %+v", tender)
+		}
+		if tender.Version != 1 || resp.version() != 1 {
+			t.Fatalf("new tender version %d, ETag %d, want 1", tender.Version, resp.version())
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		user1.createTender(t, orgId, "Доставка", "Delivery")
+
+		var tenders []tenderDTO
+		user1.do(t, http.MethodGet, "/api/tenders", nil).expect(http.StatusOK).decode(&tenders)
+		if len(tenders) != 2 {
+			t.Fatalf("got %d tenders, want 2", len(tenders))
+		}
+
+		user1.do(t, http.MethodGet, "/api/tenders?service_type=Construction", nil).
+			expect(http.StatusOK).decode(&tenders)
+		if len(tenders) != 1 || tenders[0].ID != tender.ID {
+			t.Fatalf("service_type filter returned %+v", tenders)
+		}
+	})
+
+	t.Run("my", func(t *testing.T) {
+		var tenders []tenderDTO
+		user1.do(t, http.MethodGet, "/api/tenders/my?limit=1", nil).expect(http.StatusOK).decode(&tenders)
+		if len(tenders) != 1 {
+			t.Fatalf("got %d tenders with limit=1", len(tenders))
+		}
+	})
+
+	t.Run("status", func(t *testing.T) {
+		var status string
+		user1.do(t, http.MethodGet, "/api/tenders/"+tender.ID+"/status", nil).expect(http.StatusOK).decode(&status)
+		if status != "Created" {
+			t.Fatalf("status %q, want Created", status)
+		}
+
+		// Неопубликованный тендер не виден посторонним
+		s.anonymous().do(t, http.MethodGet, "/api/tenders/"+tender.ID+"/status", nil).expect(http.StatusForbidden)
+
+		tender = user1.setTenderStatus(t, tender.ID, "Published")
+		if tender.Status != "Published" || tender.Version != 2 {
+			t.Fatalf("after publish %+v", tender)
+		}
+		s.anonymous().do(t, http.MethodGet, "/api/tenders/"+tender.ID+"/status", nil).
+			expect(http.StatusOK).decode(&status)
+		if status != "Published" {
+			t.Fatalf("anonymous sees status %q", status)
+		}
+
+		user1.do(t, http.MethodPut, "/api/tenders/"+tender.ID+"/status?status=Unknown", nil).
+			expect(http.StatusBadRequest)
+	})
+
+	t.Run("edit", func(t *testing.T) {
+		resp := user1.do(t, http.MethodPatch, "/api/tenders/"+tender.ID+"/edit", map[string]string{
+			"name":        "Обновленный Тендер 1",
+			"description": "Обновленное описание",
+			"serviceType": "Manufacture",
+		}).expect(http.StatusOK)
+		resp.decode(&tender)
+
+		if tender.Name != "Обновленный Тендер 1" || tender.Description != "Обновленное описание" ||
+			tender.ServiceType != "Manufacture" {
+			t.Fatalf("unexpected tender after edit %+v", tender)
+		}
+		if tender.Version != 3 || resp.version() != 3 {
+			t.Fatalf("version after edit %d, ETag %d, want 3", tender.Version, resp.version())
+		}
+	})
+
+	t.Run("edit with stale If-Match", func(t *testing.T) {
+		var current tenderDTO
+		resp := user1.do(t, http.MethodPatch, "/api/tenders/"+tender.ID+"/edit",
+			map[string]string{"name": "Устаревшая правка"},
+			"If-Match", strconv.Quote("1")).expect(http.StatusPreconditionFailed)
+		resp.decode(&current)
+
+		if current.Name != tender.Name || resp.version() != tender.Version {
+			t.Fatalf("412 must return current state, got %+v", current)
+		}
+	})
+
+	t.Run("edit with weak If-Match", func(t *testing.T) {
+		// If-Match сравнивает ETag строго: слабый валидатор не совпадает даже с актуальной версией
+		user1.do(t, http.MethodPatch, "/api/tenders/"+tender.ID+"/edit",
+			map[string]string{"name": "Правка со слабым ETag"},
+			"If-Match", "W/"+strconv.Quote(strconv.Itoa(tender.Version))).
+			expect(http.StatusPreconditionFailed).problem("weak_etag")
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		resp := user1.do(t, http.MethodPut, "/api/tenders/"+tender.ID+"/rollback/2", nil,
+			"If-Match", strconv.Quote(strconv.Itoa(tender.Version))).expect(http.StatusOK)
+		resp.decode(&tender)
+
+		if tender.Name != "Тендер 1" || tender.Description != "Описание тендера" || tender.ServiceType != "Construction" {
+			t.Fatalf("rollback did not restore version 2: %+v", tender)
+		}
+		if tender.Status != "Published" || tender.Version != 4 {
+			t.Fatalf("rollback must keep status and bump version: %+v", tender)
+		}
+
+		user1.do(t, http.MethodPut, "/api/tenders/"+tender.ID+"/rollback/42", nil).expect(http.StatusNotFound)
+	})
+
+	t.Run("access", func(t *testing.T) {
+		stranger := s.signUp(t, "stranger")
+
+		stranger.do(t, http.MethodPatch, "/api/tenders/"+tender.ID+"/edit",
+			map[string]string{"name": "Чужая правка"}).expect(http.StatusForbidden)
+		stranger.do(t, http.MethodPost, "/api/tenders/new", map[string]string{
+			"name":           "Чужой тендер",
+			"description":    "Описание",
+			"serviceType":    "Delivery",
+			"organizationId": orgId,
+		}).expect(http.StatusForbidden)
+	})
+}
+
+func TestTendersCursorPagination(t *testing.T) {
+	s := newTestServer(t)
+	user := s.signUp(t, "user1")
+	orgId := user.createOrganization(t, "Org 1")
+
+	var created []tenderDTO
+	for _, name := range []string{"Тендер 1", "Тендер 2", "Тендер 3"} {
+		created = append(created, user.createTender(t, orgId, name, "Construction"))
+	}
+
+	var first []tenderDTO
+	resp := user.do(t, http.MethodGet, "/api/tenders/my?limit=2&total=true", nil).expect(http.StatusOK)
+	resp.decode(&first)
+	if len(first) != 2 || first[0].ID != created[2].ID || first[1].ID != created[1].ID {
+		t.Fatalf("first page %+v, want two newest tenders", first)
+	}
+	if total := resp.header.Get("X-Total-Count"); total != "3" {
+		t.Fatalf("X-Total-Count %q, want 3", total)
+	}
+	cursor := resp.header.Get("X-Next-Cursor")
+	if cursor == "" {
+		t.Fatal("full page without X-Next-Cursor")
+	}
+
+	// Новый тендер попадает в начало списка и не сдвигает следующую страницу
+	user.createTender(t, orgId, "Тендер 4", "Construction")
+
+	var second []tenderDTO
+	resp = user.do(t, http.MethodGet, "/api/tenders/my?limit=2&cursor="+cursor, nil).expect(http.StatusOK)
+	resp.decode(&second)
+	if len(second) != 1 || second[0].ID != created[0].ID {
+		t.Fatalf("second page %+v, want the oldest tender", second)
+	}
+	if next := resp.header.Get("X-Next-Cursor"); next != "" {
+		t.Fatalf("last page has X-Next-Cursor %q", next)
+	}
+	if total := resp.header.Get("X-Total-Count"); total != "" {
+		t.Fatalf("X-Total-Count %q without total=true", total)
+	}
+
+	// С envelope=true курсор и общее число дублируются в теле
+	var page struct {
+		Items      []tenderDTO `json:"items"`
+		NextCursor string      `json:"nextCursor"`
+		Total      *int        `json:"total"`
+	}
+	resp = user.do(t, http.MethodGet, "/api/tenders/my?limit=2&total=true&envelope=true", nil).expect(http.StatusOK)
+	resp.decode(&page)
+	if len(page.Items) != 2 || page.Total == nil || *page.Total != 4 {
+		t.Fatalf("envelope page %+v, want two of four tenders", page)
+	}
+	if page.NextCursor == "" || page.NextCursor != resp.header.Get("X-Next-Cursor") {
+		t.Fatalf("envelope nextCursor %q, header %q", page.NextCursor, resp.header.Get("X-Next-Cursor"))
+	}
+
+	user.do(t, http.MethodGet, "/api/tenders/my?cursor=garbage", nil).expect(http.StatusBadRequest)
+
+	// Пустая страница — не ошибка
+	newcomer := s.signUp(t, "user2")
+	var empty []tenderDTO
+	newcomer.do(t, http.MethodGet, "/api/tenders/my", nil).expect(http.StatusOK).decode(&empty)
+	if empty == nil || len(empty) != 0 {
+		t.Fatalf("empty tenders page %+v, want []", empty)
+	}
+	var bids []bidDTO
+	newcomer.do(t, http.MethodGet, "/api/bids/my?total=true", nil).expect(http.StatusOK).decode(&bids)
+	if bids == nil || len(bids) != 0 {
+		t.Fatalf("empty bids page %+v, want []", bids)
+	}
+}
+
+type tenderSearchDTO struct {
+	Tender  tenderDTO `json:"tender"`
+	Rank    float64   `json:"rank"`
+	Snippet string    `json:"snippet"`
+}
+
+func TestTenderSearch(t *testing.T) {
+	s := newTestServer(t)
+	user := s.signUp(t, "user1")
+	orgId := user.createOrganization(t, "Org 1")
+
+	create := func(name, description, serviceType string, publish bool) tenderDTO {
+		var tender tenderDTO
+		user.do(t, http.MethodPost, "/api/tenders/new", map[string]string{
+			"name":           name,
+			"description":    description,
+			"serviceType":    serviceType,
+			"organizationId": orgId,
+		}).expect(http.StatusOK).decode(&tender)
+		if publish {
+			tender = user.setTenderStatus(t, tender.ID, "Published")
+		}
+		return tender
+	}
+	inTitle := create("Асфальт в Казани", "Укладка асфальта во дворах", "Construction", true)
+	inDescription := create("Доставка оборудования", "Привезти асфальт и песок в Казань", "Delivery", true)
+	markup := create("Ремонт дорог Москвы", "Асфальт <b>срочно</b>", "Construction", true)
+	draft := create("Асфальт Казань", "Черновик", "Construction", false)
+
+	search := func(c *apiClient, query string) []tenderSearchDTO {
+		t.Helper()
+		var res []tenderSearchDTO
+		c.do(t, http.MethodGet, "/api/tenders/search?q="+query, nil).expect(http.StatusOK).decode(&res)
+		return res
+	}
+	ids := func(res []tenderSearchDTO) []string {
+		var ids []string
+		for _, r := range res {
+			ids = append(ids, r.Tender.ID)
+		}
+		return ids
+	}
+
+	// Совпадение в названии весит больше, чем в описании, а неопубликованный тендер аноним не находит
+	res := search(s.anonymous(), url.QueryEscape("асфальт Казань"))
+	if got := ids(res); len(got) != 2 || got[0] != inTitle.ID || got[1] != inDescription.ID {
+		t.Fatalf("anonymous search found %v, want [%s %s]", got, inTitle.ID, inDescription.ID)
+	}
+	if res[0].Rank <= res[1].Rank || !strings.Contains(res[0].Snippet, "<mark>Казани</mark>") {
+		t.Fatalf("unexpected ranking or snippet %+v", res)
+	}
+
+	if got := ids(search(user, url.QueryEscape("асфальт Казань"))); len(got) != 3 || !slices.Contains(got, draft.ID) {
+		t.Fatalf("organization member search found %v, want the draft too", got)
+	}
+	if got := ids(search(user, url.QueryEscape("асфальт Казань")+"&service_type=Delivery")); len(got) != 1 ||
+		got[0] != inDescription.ID {
+		t.Fatalf("service_type filter found %v, want [%s]", got, inDescription.ID)
+	}
+	if got := ids(search(s.anonymous(), url.QueryEscape("асфальт Казань -доставка"))); len(got) != 1 ||
+		got[0] != inTitle.ID {
+		t.Fatalf("exclusion found %v, want [%s]", got, inTitle.ID)
+	}
+
+	// Разметка из описания не попадает в сниппет как есть
+	res = search(s.anonymous(), url.QueryEscape("срочно"))
+	if len(res) != 1 || res[0].Tender.ID != markup.ID {
+		t.Fatalf("search for markup found %+v", res)
+	}
+	if strings.Contains(res[0].Snippet, "<b>") || !strings.Contains(res[0].Snippet, "<mark>срочно</mark>") {
+		t.Fatalf("snippet %q is not escaped", res[0].Snippet)
+	}
+
+	if res := search(s.anonymous(), url.QueryEscape("бетон")); res == nil || len(res) != 0 {
+		t.Fatalf("search without matches returned %+v, want []", res)
+	}
+	s.anonymous().do(t, http.MethodGet, "/api/tenders/search", nil).expect(http.StatusBadRequest)
+}
+
+func TestTenderFilters(t *testing.T) {
+	s := newTestServer(t)
+	user1 := s.signUp(t, "user1")
+	user2 := s.signUp(t, "user2")
+	org1 := user1.createOrganization(t, "Org 1")
+	org2 := user2.createOrganization(t, "Org 2")
+
+	user1.setTenderStatus(t, user1.createTender(t, org1, "Alpha", "Construction").ID, "Published")
+	beta := user1.createTender(t, org1, "Beta", "Delivery")
+	gamma := user1.setTenderStatus(t, user1.createTender(t, org1, "Gamma", "Construction").ID, "Published")
+	user1.do(t, http.MethodPatch, "/api/tenders/"+gamma.ID+"/edit",
+		map[string]string{"description": "Новое описание"}).expect(http.StatusOK).decode(&gamma)
+	delta := user2.setTenderStatus(t, user2.createTender(t, org2, "Delta", "Delivery").ID, "Published")
+
+	moscow := time.FixedZone("MSK", 3*60*60)
+	list := func(c *apiClient, query string) string {
+		t.Helper()
+		var tenders []tenderDTO
+		c.do(t, http.MethodGet, "/api/tenders?"+query, nil).expect(http.StatusOK).decode(&tenders)
+		names := make([]string, 0, len(tenders))
+		for _, tender := range tenders {
+			names = append(names, tender.Name)
+		}
+		return strings.Join(names, ",")
+	}
+	cases := []struct {
+		client *apiClient
+		query  string
+		want   string
+	}{
+		{s.anonymous(), "status=Published", "Delta,Gamma,Alpha"},
+		{user1, "status=Created", "Beta"},
+		{user1, "organizationId=" + org1 + "&status=Published", "Gamma,Alpha"},
+		{user1, "creator=user2", "Delta"},
+		{user1, "version=" + strconv.Itoa(gamma.Version), "Gamma"},
+		{user1, "createdFrom=" + url.QueryEscape(beta.CreatedAt.Format(time.RFC3339Nano)) +
+			"&createdTo=" + url.QueryEscape(delta.CreatedAt.Format(time.RFC3339Nano)), "Gamma,Beta"},
+		// Границы с другим часовым поясом обозначают те же моменты времени
+		{user1, "createdFrom=" + url.QueryEscape(beta.CreatedAt.In(moscow).Format(time.RFC3339Nano)) +
+			"&createdTo=" + url.QueryEscape(delta.CreatedAt.In(moscow).Format(time.RFC3339Nano)), "Gamma,Beta"},
+		{user1, "sort=name", "Alpha,Beta,Delta,Gamma"},
+		{user1, "sort=-serviceType,name", "Beta,Delta,Alpha,Gamma"},
+		{user1, "sort=serviceType&service_type=Construction", "Gamma,Alpha"},
+	}
+	for _, c := range cases {
+		if got := list(c.client, c.query); got != c.want {
+			t.Errorf("GET /api/tenders?%s: got %s, want %s", c.query, got, c.want)
+		}
+	}
+
+	// С сортировкой клиента курсор не выдается и не принимается
+	resp := user1.do(t, http.MethodGet, "/api/tenders?sort=name&limit=2", nil).expect(http.StatusOK)
+	if cursor := resp.header.Get("X-Next-Cursor"); cursor != "" {
+		t.Fatalf("sorted page has X-Next-Cursor %q", cursor)
+	}
+	cursor := user1.do(t, http.MethodGet, "/api/tenders?limit=2", nil).expect(http.StatusOK).header.Get("X-Next-Cursor")
+	user1.do(t, http.MethodGet, "/api/tenders?sort=name&cursor="+cursor, nil).expect(http.StatusBadRequest)
+	user1.do(t, http.MethodGet, "/api/tenders?sort=title", nil).expect(http.StatusBadRequest)
+	user1.do(t, http.MethodGet, "/api/tenders?sort=name;DROP", nil).expect(http.StatusBadRequest)
+}
+
+func TestTenderDeadline(t *testing.T) {
+	s := newTestServer(t)
+	buyer := s.signUp(t, "buyer")
+	supplier := s.signUp(t, "supplier")
+	buyerOrg := buyer.createOrganization(t, "Buyer")
+	supplierOrg := supplier.createOrganization(t, "Supplier")
+
+	create := func(deadline time.Time) *apiResponse {
+		return buyer.do(t, http.MethodPost, "/api/tenders/new", map[string]string{
+			"name":               "Тендер со сроком",
+			"description":        "Описание",
+			"serviceType":        "Delivery",
+			"organizationId":     buyerOrg,
+			"submissionDeadline": deadline.Format(time.RFC3339Nano),
+		})
+	}
+	create(time.Now().Add(-time.Hour)).expect(http.StatusBadRequest).problem("invalid_submission_deadline")
+
+	deadline := time.Now().Add(300 * time.Millisecond)
+	var expiring tenderDTO
+	create(deadline).expect(http.StatusOK).decode(&expiring)
+	// Postgres хранит время с точностью до микросекунды
+	if expiring.SubmissionDeadline == nil || deadline.Sub(*expiring.SubmissionDeadline).Abs() >= time.Microsecond {
+		t.Fatalf("submissionDeadline %v, want %v", expiring.SubmissionDeadline, deadline)
+	}
+	expiring = buyer.setTenderStatus(t, expiring.ID, "Published")
+	open := buyer.setTenderStatus(t, buyer.createTender(t, buyerOrg, "Без срока", "Delivery").ID, "Published")
+	manual := buyer.setTenderStatus(t, buyer.createTender(t, buyerOrg, "Закрыть вручную", "Delivery").ID, "Published")
+
+	supplier.createBid(t, expiring.ID, supplierOrg, "В срок")
+
+	time.Sleep(time.Until(deadline) + 50*time.Millisecond)
+	supplier.do(t, http.MethodPost, "/api/bids/new", map[string]string{
+		"name":           "Опоздавшее",
+		"description":    "Описание",
+		"tenderId":       expiring.ID,
+		"organizationId": supplierOrg,
+	}).expect(http.StatusBadRequest).problem("submission_deadline_passed")
+
+	manual = buyer.setTenderStatus(t, manual.ID, "Closed")
+	if manual.ClosedBy != "buyer" {
+		t.Fatalf("manually closed tender has closedBy %q, want buyer", manual.ClosedBy)
+	}
+
+	closed, err := s.services.CloseExpired(context.Background(), time.Now())
+	if err != nil || closed != 1 {
+		t.Fatalf("CloseExpired closed %d, error %v, want 1", closed, err)
+	}
+	closed, err = s.services.CloseExpired(context.Background(), time.Now())
+	if err != nil || closed != 0 {
+		t.Fatalf("second CloseExpired closed %d, error %v, want 0", closed, err)
+	}
+
+	var tenders []tenderDTO
+	buyer.do(t, http.MethodGet, "/api/tenders/my", nil).expect(http.StatusOK).decode(&tenders)
+	for _, tender := range tenders {
+		switch tender.ID {
+		case expiring.ID:
+			if tender.Status != "Closed" || tender.ClosedBy != "system" {
+				t.Fatalf("expired tender %+v, want Closed by system", tender)
+			}
+		case open.ID:
+			if tender.Status != "Published" || tender.ClosedBy != "" {
+				t.Fatalf("tender without deadline %+v, want Published", tender)
+			}
+		}
+	}
+}
+
+func TestTenderScheduledPublication(t *testing.T) {
+	s := newTestServer(t)
+	owner := s.signUp(t, "owner")
+	manager := s.signUp(t, "manager")
+	stranger := s.signUp(t, "stranger")
+	org := owner.createOrganization(t, "Org")
+	owner.addResponsible(t, org, manager.username, "tender_manager")
+
+	create := func(publishAt time.Time) *apiResponse {
+		return owner.do(t, http.MethodPost, "/api/tenders/new", map[string]string{
+			"name":           "Отложенный тендер",
+			"description":    "Описание",
+			"serviceType":    "Delivery",
+			"organizationId": org,
+			"publishAt":      publishAt.Format(time.RFC3339Nano),
+		})
+	}
+	schedule := func(c *apiClient, tenderId string, publishAt time.Time) *apiResponse {
+		return c.do(t, http.MethodPut, "/api/tenders/"+tenderId+"/publication",
+			map[string]string{"publishAt": publishAt.Format(time.RFC3339Nano)})
+	}
+	publication := func(tenderId string) publicationDTO {
+		var res publicationDTO
+		owner.do(t, http.MethodGet, "/api/tenders/"+tenderId+"/publication", nil).
+			expect(http.StatusOK).decode(&res)
+		return res
+	}
+	status := func(tenderId string) string {
+		var res string
+		owner.do(t, http.MethodGet, "/api/tenders/"+tenderId+"/status", nil).expect(http.StatusOK).decode(&res)
+		return res
+	}
+
+	create(time.Now().Add(-time.Minute)).expect(http.StatusBadRequest).problem("invalid_publish_at")
+
+	soon := time.Now().Add(200 * time.Millisecond)
+	var due tenderDTO
+	create(soon).expect(http.StatusOK).decode(&due)
+	if due.Status != "Created" || due.PublishAt == nil {
+		t.Fatalf("created tender %+v, want Created with publishAt", due)
+	}
+	if p := publication(due.ID); p.Status != "Pending" || p.CreatedBy != "owner" {
+		t.Fatalf("publication %+v, want Pending by owner", p)
+	}
+	stranger.do(t, http.MethodGet, "/api/tenders/"+due.ID+"/publication", nil).
+		expect(http.StatusForbidden)
+
+	// Перенесенная публикация не срабатывает в прежнее время
+	moved := owner.createTender(t, org, "Перенесенный", "Delivery")
+	owner.do(t, http.MethodGet, "/api/tenders/"+moved.ID+"/publication", nil).
+		expect(http.StatusNotFound).problem("publication_not_found")
+	schedule(owner, moved.ID, soon).expect(http.StatusOK)
+	later := time.Now().Add(time.Hour)
+	var p publicationDTO
+	schedule(owner, moved.ID, later).expect(http.StatusOK).decode(&p)
+	if p.Status != "Pending" || later.Sub(p.PublishAt).Abs() >= time.Microsecond {
+		t.Fatalf("moved publication %+v, want Pending at %v", p, later)
+	}
+
+	canceled := owner.createTender(t, org, "Отмененный", "Delivery")
+	schedule(owner, canceled.ID, soon).expect(http.StatusOK)
+	owner.do(t, http.MethodDelete, "/api/tenders/"+canceled.ID+"/publication", nil).
+		expect(http.StatusOK).decode(&p)
+	if p.Status != "Canceled" {
+		t.Fatalf("canceled publication %+v, want Canceled", p)
+	}
+	owner.do(t, http.MethodDelete, "/api/tenders/"+canceled.ID+"/publication", nil).
+		expect(http.StatusConflict).problem("publication_not_pending")
+
+	// Ручная публикация отменяет отложенную
+	manual := owner.createTender(t, org, "Опубликован вручную", "Delivery")
+	schedule(owner, manual.ID, soon).expect(http.StatusOK)
+	owner.setTenderStatus(t, manual.ID, "Published")
+	if p := publication(manual.ID); p.Status != "Canceled" {
+		t.Fatalf("publication of manually published tender %+v, want Canceled", p)
+	}
+	schedule(owner, manual.ID, later).expect(http.StatusBadRequest).problem("invalid_status_transition")
+
+	// Публикация выполняется от имени запланировавшего: без его прав она не удается
+	revoked := owner.createTender(t, org, "Без прав", "Delivery")
+	schedule(manager, revoked.ID, soon).expect(http.StatusOK)
+	owner.do(t, http.MethodDelete, "/api/organizations/"+org+"/responsibles/"+manager.username, nil).
+		expect(http.StatusNoContent)
+
+	time.Sleep(time.Until(soon) + 50*time.Millisecond)
+	published, err := s.services.PublishDue(context.Background(), time.Now())
+	if err != nil || published != 1 {
+		t.Fatalf("PublishDue published %d, error %v, want 1", published, err)
+	}
+	if st := status(due.ID); st != "Published" {
+		t.Fatalf("scheduled tender status %q, want Published", st)
+	}
+	if p := publication(due.ID); p.Status != "Done" {
+		t.Fatalf("publication %+v, want Done", p)
+	}
+	if st := status(moved.ID); st != "Created" {
+		t.Fatalf("moved tender status %q, want Created", st)
+	}
+	if p := publication(revoked.ID); p.Status != "Failed" || p.Error != "access_denied" {
+		t.Fatalf("publication without rights %+v, want Failed with access_denied", p)
+	}
+	if st := status(revoked.ID); st != "Created" {
+		t.Fatalf("tender without publication rights status %q, want Created", st)
+	}
+}
@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"context"
+	"zadanie-6105/internal/model"
+)
+
+type employeeKey struct{}
+
+func WithEmployee(ctx context.Context, employee model.Employee) context.Context {
+	return context.WithValue(ctx, employeeKey{}, employee)
+}
+
+// EmployeeFromContext возвращает сотрудника, прошедшего аутентификацию в текущем запросе.
+func EmployeeFromContext(ctx context.Context) (model.Employee, bool) {
+	employee, ok := ctx.Value(employeeKey{}).(model.Employee)
+	return employee, ok
+}
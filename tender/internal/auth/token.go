@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+type claims struct {
+	Username string `json:"username"`
+	jwt.RegisteredClaims
+}
+
+// Identity — сотрудник, которому выпущен токен: id из sub и имя пользователя.
+type Identity struct {
+	EmployeeID uuid.UUID
+	Username   string
+}
+
+// TokenManager выпускает и проверяет JWT, подписанные локальным ключом (HS256).
+type TokenManager struct {
+	key []byte
+	ttl time.Duration
+}
+
+func NewTokenManager(key string, ttl time.Duration) *TokenManager {
+	return &TokenManager{
+		key: []byte(key),
+		ttl: ttl,
+	}
+}
+
+func (tM *TokenManager) Issue(employee model.Employee) (string, time.Time, error) {
+	path := "internal.auth.token.Issue"
+
+	now := time.Now()
+	expiresAt := now.Add(tM.ttl)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		Username: employee.Username,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   employee.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	signed, err := token.SignedString(tM.key)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf(path+".SignedString, error: {%s}", err.Error())
+	}
+	return signed, expiresAt, nil
+}
+
+// Parse проверяет подпись и срок действия токена и возвращает сотрудника, которому он выпущен.
+func (tM *TokenManager) Parse(token string) (Identity, error) {
+	var c claims
+	_, err := jwt.ParseWithClaims(token, &c, func(*jwt.Token) (interface{}, error) {
+		return tM.key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return Identity{}, custom_errors.ErrTokenExpired
+		}
+		return Identity{}, custom_errors.ErrInvalidToken
+	}
+	employeeID, err := uuid.Parse(c.Subject)
+	if err != nil || c.Username == "" {
+		return Identity{}, custom_errors.ErrInvalidToken
+	}
+	return Identity{EmployeeID: employeeID, Username: c.Username}, nil
+}
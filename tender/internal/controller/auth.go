@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"zadanie-6105/internal/api"
+	"zadanie-6105/internal/auth"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/service"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+type authRoutes struct {
+	authService service.IAuth
+}
+
+func (aR *authRoutes) Login(ctx *fiber.Ctx) error {
+	path := "internal.controller.auth.Login"
+
+	var lP api.LoginJSONRequestBody
+	err := ctx.BodyParser(&lP)
+	if err != nil {
+		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
+		return custom_errors.ErrUnprocessableEntity
+	}
+	if lP.Username == "" || lP.Password == "" {
+		return custom_errors.ErrUnprocessableEntity
+	}
+
+	token, expiresAt, err := aR.authService.Login(ctx.UserContext(), lP.Username, lP.Password)
+	if err != nil {
+		return fmt.Errorf(path+".Login, error: {%w}", err)
+	}
+
+	return httpResponse(ctx, fiber.StatusOK, api.Token{Token: token, ExpiresAt: expiresAt})
+}
+
+type authMiddleware struct {
+	authService     service.IAuth
+	employeeService service.IEmployee
+	devMode         bool
+}
+
+// authenticate определяет пользователя по bearer-токену и кладет его в контекст запроса.
+// В dev-режиме вместо токена можно передать параметр username. Запросы без учетных данных
+// проходят дальше анонимно, а закрытые маршруты отсекает requireEmployee.
+func (aM *authMiddleware) authenticate(ctx *fiber.Ctx) error {
+	path := "internal.controller.auth.authenticate"
+
+	if header := ctx.Get(fiber.HeaderAuthorization); header != "" {
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || token == "" {
+			return custom_errors.ErrInvalidToken
+		}
+		employee, err := aM.authService.Authenticate(ctx.UserContext(), token)
+		if err != nil {
+			return fmt.Errorf(path+".Authenticate, error: {%w}", err)
+		}
+		setEmployee(ctx, employee)
+		return ctx.Next()
+	}
+
+	if username := ctx.Query("username"); aM.devMode && username != "" {
+		employee, err := aM.employeeService.GetEmployee(ctx.UserContext(), username)
+		if err != nil {
+			return fmt.Errorf(path+".GetEmployee, error: {%w}", err)
+		}
+		setEmployee(ctx, employee)
+	}
+	return ctx.Next()
+}
+
+func requireEmployee(ctx *fiber.Ctx) error {
+	if _, ok := auth.EmployeeFromContext(ctx.UserContext()); !ok {
+		return custom_errors.ErrUnauthorized
+	}
+	return ctx.Next()
+}
+
+func setEmployee(ctx *fiber.Ctx, employee model.Employee) {
+	ctx.SetUserContext(auth.WithEmployee(ctx.UserContext(), employee))
+}
+
+// currentUsername возвращает имя аутентифицированного пользователя или пустую строку для анонимного запроса.
+func currentUsername(ctx *fiber.Ctx) string {
+	employee, _ := auth.EmployeeFromContext(ctx.UserContext())
+	return employee.Username
+}
@@ -3,15 +3,12 @@ package controller
 import (
 	"errors"
 	"fmt"
-	"strconv"
-	"time"
-	"zadanie-6105/helper"
+	"zadanie-6105/internal/api"
 	custom_errors "zadanie-6105/internal/custom-errors"
 	"zadanie-6105/internal/model"
 	"zadanie-6105/internal/service"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/google/uuid"
 	"github.com/gookit/slog"
 )
 
@@ -19,369 +16,316 @@ type bidsRoutes struct {
 	bidsService service.IBids
 }
 
-func newBidsRoutes(g fiber.Router, bidsService service.IBids) {
-	aR := &bidsRoutes{bidsService: bidsService}
-
-	g.Post("/new", aR.newBids)
-	g.Get("/my", aR.my)
-	g.Get("/:tenderId/list", aR.bids)
-	g.Patch("/:bidId/edit", aR.edit)
-	g.Get("/:bidId/status", aR.status)
-	g.Put("/:bidId/status", aR.editStatus)
-	g.Put(":bidId/submit_decision", aR.submitDecision)
+// newBidsResponse: предложение всегда подается от имени организации, поэтому автором считается она.
+func newBidsResponse(b model.Bids) api.Bid {
+	return api.Bid{
+		Id:              b.ID,
+		Name:            b.Title,
+		Description:     b.Description,
+		Status:          api.BidStatus(b.Status),
+		TenderId:        b.TenderID,
+		AuthorType:      api.BidAuthorTypeOrganization,
+		AuthorId:        b.OrganizationID,
+		CreatorUsername: b.CreatorUsername,
+		Version:         api.BidVersion(b.Version),
+		CreatedAt:       b.CreatedAt,
+	}
 }
 
-type bidsSliceResponse struct {
-	Bids []bidsResponse `json:"bids"`
-}
-type bidsResponse struct {
-	ID              uuid.UUID `json:"id"`
-	Name            string    `json:"name"`
-	Description     string    `json:"description"`
-	Status          string    `json:"status"`
-	Version         int       `json:"version"`
-	CreatedAt       time.Time `json:"created_at"`
-	CreatorUsername string    `json:"creatorUsername"`
-}
-type bidsParams struct {
-	Bids *model.Bids `json:"bids"`
+func newBidsSliceResponse(bids []model.Bids) []api.Bid {
+	resp := make([]api.Bid, 0, len(bids))
+	for _, b := range bids {
+		resp = append(resp, newBidsResponse(b))
+	}
+	return resp
 }
 
-func (bR *bidsRoutes) newBids(ctx *fiber.Ctx) error {
-	path := "internal.controller.bids.newBids"
-
-	var bP bidsParams
+func (bR *bidsRoutes) CreateBid(ctx *fiber.Ctx) error {
+	path := "internal.controller.bids.CreateBid"
 
-	err := ctx.BodyParser(&bP.Bids)
+	var body api.CreateBidJSONRequestBody
+	err := ctx.BodyParser(&body)
 	if err != nil {
 		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
-		return wrapHttpError(ctx, 500, "internal error")
-	}
-	bP.Bids.Status = "Created"
-	res, err := bR.bidsService.CreateBids(ctx.Context(), bP.Bids)
+		return custom_errors.ErrUnprocessableEntity
+	}
+	res, err := bR.bidsService.CreateBids(ctx.UserContext(), &model.Bids{
+		TenderID:       body.TenderId,
+		OrganizationID: body.OrganizationId,
+		Title:          body.Name,
+		Description:    body.Description,
+		// Автором предложения всегда становится пользователь, прошедший аутентификацию
+		CreatorUsername: currentUsername(ctx),
+	})
 	if err != nil {
-		if errors.Is(err, custom_errors.ErrAccessDenied) {
-			return wrapHttpError(ctx, 403, custom_errors.ErrAccessDenied.Error())
-		}
-		if errors.Is(err, custom_errors.ErrTenderAlreadyExists) {
-			return wrapHttpError(ctx, 401, custom_errors.ErrTenderAlreadyExists.Error())
-		}
-		slog.Errorf(path+".CreateTender, error: {%s}", err.Error())
-		return err
+		return fmt.Errorf(path+".CreateBids, error: {%w}", err)
 	}
 
-	resp := bidsResponse{
-		ID:              res.ID,
-		Name:            res.Title,
-		Description:     res.Description,
-		Status:          res.Status,
-		Version:         res.Version,
-		CreatedAt:       res.CreatedAt,
-		CreatorUsername: res.CreatorUsername,
-	}
-	err = httpResponse(ctx, fiber.StatusOK, resp)
-	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
-	}
-	return nil
+	resp := newBidsResponse(res)
+	setVersionETag(ctx, res.Version)
+	return httpResponse(ctx, fiber.StatusOK, resp)
 }
 
-func (bR *bidsRoutes) my(ctx *fiber.Ctx) error {
-	path := "internal.controller.bids.my"
-	m := ctx.Queries()
-
-	limitStr := m["limit"]
-	var limitInt int
-	var err error
+func (bR *bidsRoutes) GetUserBids(ctx *fiber.Ctx, params api.GetUserBidsParams) error {
+	path := "internal.controller.bids.GetUserBids"
 
-	if limitStr != "" {
-		limitInt, err = strconv.Atoi(limitStr)
-		if err != nil {
-			slog.Errorf(path+".Atoi, error: {%s}", err)
-			return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-		}
-	} else {
-		limitInt = 5
+	page, err := keysetPagination(params.Limit, params.Offset, params.Cursor, params.Total)
+	if err != nil {
+		return err
 	}
-	offsetStr := m["offset"]
-	var offsetInt int
 
-	if offsetStr != "" {
-		offsetInt, err = strconv.Atoi(offsetStr)
-		if err != nil {
-			slog.Errorf(path+".Atoi, error: {%s}", err)
-			return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-		}
-	} else {
-		offsetInt = 0
+	res, err := bR.bidsService.GetBids(ctx.UserContext(), currentUsername(ctx), page)
+	if err != nil {
+		return fmt.Errorf(path+".GetBids, error: {%w}", err)
 	}
+	return pageResponse(ctx, res, newBidsSliceResponse(res.Items), params.Envelope)
+}
+
+func (bR *bidsRoutes) GetBidsForTender(
+	ctx *fiber.Ctx,
+	tenderId api.TenderId,
+	params api.GetBidsForTenderParams,
+) error {
+	path := "internal.controller.bids.GetBidsForTender"
 
-	user := ctx.Queries()
-	res, err := bR.bidsService.GetBids(ctx.Context(), user["username"], limitInt, offsetInt)
+	page, err := keysetPagination(params.Limit, params.Offset, params.Cursor, params.Total)
 	if err != nil {
-		if errors.Is(err, custom_errors.ErrBidsNotFound) || len(res) == 0 {
-			return wrapHttpError(ctx, 401, custom_errors.ErrBidsNotFound.Error())
-		}
-		slog.Errorf(path+".GetTender, error: {%s}", err)
 		return err
 	}
-	resp := bidsSliceResponse{}
-
-	for _, v := range res {
-		resp.Bids = append(resp.Bids, bidsResponse{
-			ID:              v.ID,
-			Name:            v.Title,
-			Description:     v.Description,
-			Status:          v.Status,
-			CreatorUsername: v.CreatorUsername,
-			Version:         v.Version,
-			CreatedAt:       v.CreatedAt,
-		})
-	}
-	err = httpResponse(ctx, fiber.StatusOK, resp)
+	res, err := bR.bidsService.GetBidsByTenderId(ctx.UserContext(), currentUsername(ctx), tenderId, page)
 	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
+		return fmt.Errorf(path+".GetBidsByTenderId, error: {%w}", err)
 	}
-	return nil
+	return pageResponse(ctx, res, newBidsSliceResponse(res.Items), params.Envelope)
 }
 
-func (bR *bidsRoutes) bids(ctx *fiber.Ctx) error {
-	path := "internal.controller.bids.bids"
-	m := ctx.Queries()
-
-	limitStr := m["limit"]
-	var limitInt int
-	var err error
-
-	if limitStr != "" {
-		limitInt, err = strconv.Atoi(limitStr)
-		if err != nil {
-			slog.Errorf(path+".Atoi, error: {%s}", err)
-			return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-		}
-	} else {
-		limitInt = 5
-	}
-	offsetStr := m["offset"]
-	var offsetInt int
+func (bR *bidsRoutes) EditBid(ctx *fiber.Ctx, bidId api.BidId, params api.EditBidParams) error {
+	path := "internal.controller.bids.EditBid"
 
-	if offsetStr != "" {
-		offsetInt, err = strconv.Atoi(offsetStr)
-		if err != nil {
-			slog.Errorf(path+".Atoi, error: {%s}", err)
-			return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-		}
-	} else {
-		offsetInt = 0
+	var body api.EditBidJSONRequestBody
+	err := ctx.BodyParser(&body)
+	if err != nil {
+		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
+		return custom_errors.ErrUnprocessableEntity
 	}
 
-	user := ctx.Queries()
-	if _, ok := user["username"]; !ok {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-	}
-	tenderId := ctx.Params("tenderId")
-	parsedID, err := uuid.Parse(tenderId)
+	expectedVersion, err := ifMatchVersion(params.IfMatch)
 	if err != nil {
-		return wrapHttpError(ctx, 400, "Invalid tenderId format")
+		return err
 	}
-	res, err := bR.bidsService.GetBidsByTenderId(ctx.Context(), user["username"], parsedID, limitInt, offsetInt)
+
+	res, err := bR.bidsService.UpdateBids(ctx.UserContext(), &model.Bids{
+		ID:              bidId,
+		Title:           valueOf(body.Name),
+		Description:     valueOf(body.Description),
+		Version:         expectedVersion,
+		CreatorUsername: currentUsername(ctx),
+	})
 	if err != nil {
-		slog.Errorf(path+".GetBidsByTenderId, error: {%s}", err.Error())
-		if errors.Is(err, custom_errors.ErrTenderNotFound) {
-			return wrapHttpError(ctx, 400, err.Error())
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return versionConflict(ctx, res.Version, newBidsResponse(res))
 		}
-		return err
+		return fmt.Errorf(path+".UpdateBids, error: {%w}", err)
 	}
-	resp := bidsSliceResponse{}
 
-	for _, v := range res {
-		resp.Bids = append(resp.Bids, bidsResponse{
-			ID:              v.ID,
-			Name:            v.Title,
-			Description:     v.Description,
-			Status:          v.Status,
-			CreatorUsername: v.CreatorUsername,
-			Version:         v.Version,
-			CreatedAt:       v.CreatedAt,
-		})
-	}
-	err = httpResponse(ctx, fiber.StatusOK, resp)
+	resp := newBidsResponse(res)
+	setVersionETag(ctx, res.Version)
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
+
+func (bR *bidsRoutes) GetBidStatus(c *fiber.Ctx, bidId api.BidId) error {
+	path := "internal.controller.bids.GetBidStatus"
+
+	res, err := bR.bidsService.GetBidStatus(c.UserContext(), bidId, currentUsername(c))
 	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
+		return fmt.Errorf(path+".GetBidStatus, error: {%w}", err)
 	}
-	return nil
+	resp := res
+	return httpResponse(c, fiber.StatusOK, resp)
 }
 
-func (bR *bidsRoutes) edit(ctx *fiber.Ctx) error {
-	path := "internal.controller.bids.edit"
+func (bR *bidsRoutes) UpdateBidStatus(ctx *fiber.Ctx, bidId api.BidId, params api.UpdateBidStatusParams) error {
+	path := "internal.controller.bids.UpdateBidStatus"
 
-	var bP bidsParams
-	err := ctx.BodyParser(&bP.Bids)
-	if err != nil {
-		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
-		return wrapHttpError(ctx, 400, "Invalid request format")
+	if params.Status == "" {
+		return custom_errors.ErrUnprocessableEntity
 	}
 
-	bidIdStr := ctx.Params("bidId")
-	parsedID, err := uuid.Parse(bidIdStr)
+	expectedVersion, err := ifMatchVersion(params.IfMatch)
 	if err != nil {
-		return wrapHttpError(ctx, 400, "Invalid bidId format")
+		return err
 	}
 
-	bP.Bids.ID = parsedID
-
-	username := ctx.Query("username")
-	if username == "" {
-		return wrapHttpError(ctx, 400, "Missing username parameter")
+	bid := model.Bids{
+		ID:              bidId,
+		Status:          string(params.Status),
+		Version:         expectedVersion,
+		CreatorUsername: currentUsername(ctx),
 	}
-	bP.Bids.CreatorUsername = username
-
-	res, err := bR.bidsService.UpdateBids(ctx.Context(), bP.Bids)
+	updatedBid, err := bR.bidsService.UpdateBidsStatus(ctx.UserContext(), bid)
 	if err != nil {
-		if errors.Is(err, custom_errors.ErrBidsNotFound) {
-			return wrapHttpError(ctx, 404, "Bid not found")
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return versionConflict(ctx, updatedBid.Version, newBidsResponse(updatedBid))
 		}
-		if errors.Is(err, custom_errors.ErrAccessDenied) {
-			return wrapHttpError(ctx, 403, "Insufficient permissions to edit the bid")
-		}
-		slog.Errorf(path+".UpdateBids, error: {%s}", err.Error())
-		return wrapHttpError(ctx, 500, "Internal server error")
+		return fmt.Errorf(path+".UpdateBidsStatus, error: {%w}", err)
 	}
 
-	resp := bidsResponse{
-		ID:              res.ID,
-		Name:            res.Title,
-		Description:     res.Description,
-		Status:          res.Status,
-		CreatorUsername: res.CreatorUsername,
-		Version:         res.Version,
-		CreatedAt:       res.CreatedAt,
+	resp := newBidsResponse(updatedBid)
+	setVersionETag(ctx, updatedBid.Version)
+
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
+
+func (bR *bidsRoutes) SubmitBidDecision(
+	ctx *fiber.Ctx,
+	bidId api.BidId,
+	params api.SubmitBidDecisionParams,
+) error {
+	path := "internal.controller.bids.SubmitBidDecision"
+
+	if params.Decision == "" {
+		return custom_errors.ErrUnprocessableEntity
 	}
-	err = httpResponse(ctx, fiber.StatusOK, resp)
+	res, err := bR.bidsService.UpdateBidsDecision(ctx.UserContext(),
+		bidId,
+		string(params.Decision),
+		currentUsername(ctx))
 	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
+		return fmt.Errorf(path+".UpdateBidsDecision, error: {%w}", err)
 	}
-	return nil
+
+	resp := newBidsResponse(res)
+	setVersionETag(ctx, res.Version)
+
+	return httpResponse(ctx, fiber.StatusOK, resp)
 }
 
-func (bR *bidsRoutes) status(c *fiber.Ctx) error {
-	path := "internal.controller.bids.status"
-	bidIdStr := c.Params("bidId")
-	parsedID, err := uuid.Parse(bidIdStr)
-	if err != nil {
-		return wrapHttpError(c, 400, custom_errors.ErrUnprocessableEntity.Error())
-	}
-	user := c.Queries()
-	if _, ok := user["username"]; !ok {
-		return wrapHttpError(c, 400, custom_errors.ErrUnprocessableEntity.Error())
-	}
-	res, err := bR.bidsService.GetBidStatus(c.Context(), parsedID, user["username"])
+func (bR *bidsRoutes) GetBidVersions(ctx *fiber.Ctx, bidId api.BidId, params api.GetBidVersionsParams) error {
+	path := "internal.controller.bids.GetBidVersions"
+
+	limit, offset, err := pagination(params.Limit, params.Offset)
 	if err != nil {
-		slog.Errorf(path+".GetStatus, error: {%s}", err.Error())
 		return err
 	}
-	resp := res
-	err = httpResponse(c, fiber.StatusOK, resp)
+	res, err := bR.bidsService.GetBidVersions(ctx.UserContext(), bidId, currentUsername(ctx), limit, offset)
 	if err != nil {
-		return wrapHttpError(c, fiber.StatusInternalServerError, "internal server error")
+		return fmt.Errorf(path+".GetBidVersions, error: {%w}", err)
 	}
-	return nil
+	return httpResponse(ctx, fiber.StatusOK, newBidsSliceResponse(res))
 }
 
-func (bR *bidsRoutes) editStatus(ctx *fiber.Ctx) error {
-	path := "internal.controller.bids.updateBidStatus"
+func (bR *bidsRoutes) GetBidVersion(ctx *fiber.Ctx, bidId api.BidId, version int32) error {
+	path := "internal.controller.bids.GetBidVersion"
 
-	bidIdStr := ctx.Params("bidId")
-	if bidIdStr == "" {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
+	if version < 1 {
+		return custom_errors.ErrUnprocessableEntity
 	}
 
-	bidId, err := uuid.Parse(bidIdStr)
+	res, err := bR.bidsService.GetBidVersion(ctx.UserContext(), bidId, int(version), currentUsername(ctx))
 	if err != nil {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
+		return fmt.Errorf(path+".GetBidVersion, error: {%w}", err)
 	}
 
-	username := ctx.Query("username")
-	if username == "" {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-	}
+	resp := newBidsResponse(res)
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
 
-	status := ctx.Query("status")
-	if status == "" {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-	}
+func (bR *bidsRoutes) RollbackBid(ctx *fiber.Ctx, bidId api.BidId, version int32, params api.RollbackBidParams) error {
+	path := "internal.controller.bids.RollbackBid"
 
-	if !helper.IsValidBidsStatus(status) {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
+	if version < 1 {
+		return custom_errors.ErrUnprocessableEntity
 	}
-
-	bid := model.Bids{
-		ID:              bidId,
-		Status:          status,
-		CreatorUsername: username,
+	expectedVersion, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return err
 	}
-	updatedBid, err := bR.bidsService.UpdateBidsStatus(ctx.Context(), bid)
+
+	res, err := bR.bidsService.RollbackBids(ctx.UserContext(),
+		bidId,
+		int(version),
+		expectedVersion,
+		currentUsername(ctx))
 	if err != nil {
-		if errors.Is(err, custom_errors.ErrBidsNotFound) {
-			return wrapHttpError(ctx, 404, custom_errors.ErrBidsNotFound.Error())
-		}
-		if errors.Is(err, custom_errors.ErrAccessDenied) {
-			return wrapHttpError(ctx, 403, custom_errors.ErrAccessDenied.Error())
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return versionConflict(ctx, res.Version, newBidsResponse(res))
 		}
-		slog.Errorf(path+".UpdateBidsStatus, error: {%s}", err.Error())
-		return wrapHttpError(ctx, 500, "Internal server error")
+		return fmt.Errorf(path+".RollbackBids, error: {%w}", err)
 	}
 
-	resp := bidsResponse{
-		ID:              updatedBid.ID,
-		Name:            updatedBid.Title,
-		Status:          updatedBid.Status,
-		CreatorUsername: updatedBid.CreatorUsername,
-		Version:         updatedBid.Version,
-		CreatedAt:       updatedBid.CreatedAt,
+	resp := newBidsResponse(res)
+	setVersionETag(ctx, res.Version)
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
+
+func (bR *bidsRoutes) SubmitBidFeedback(
+	ctx *fiber.Ctx,
+	bidId api.BidId,
+	params api.SubmitBidFeedbackParams,
+) error {
+	path := "internal.controller.bids.SubmitBidFeedback"
+
+	if params.BidFeedback == "" {
+		return custom_errors.ErrUnprocessableEntity
 	}
 
-	err = httpResponse(ctx, fiber.StatusOK, resp)
+	res, err := bR.bidsService.CreateBidFeedback(ctx.UserContext(), bidId, params.BidFeedback, currentUsername(ctx))
 	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
+		return fmt.Errorf(path+".CreateBidFeedback, error: {%w}", err)
 	}
-	return nil
+
+	resp := newBidsResponse(res)
+	return httpResponse(ctx, fiber.StatusOK, resp)
 }
 
-func (bR *bidsRoutes) submitDecision(ctx *fiber.Ctx) error {
-	path := "internal.controller.bids.submitDecision"
+func (bR *bidsRoutes) GetBidReviews(ctx *fiber.Ctx, tenderId api.TenderId, params api.GetBidReviewsParams) error {
+	path := "internal.controller.bids.GetBidReviews"
 
-	bidIdStr := ctx.Params("bidId")
-	decision := ctx.Query("decision")
-	username := ctx.Query("username")
-	if username == "" {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-	}
-	if decision == "" {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-	}
-	parsedID, err := uuid.Parse(bidIdStr)
+	limit, offset, err := pagination(params.Limit, params.Offset)
 	if err != nil {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
+		return err
 	}
-	res, err := bR.bidsService.UpdateBidsDecision(ctx.Context(), parsedID, decision, username)
+	if params.AuthorUsername == "" {
+		return custom_errors.ErrUnprocessableEntity
+	}
+
+	res, err := bR.bidsService.GetBidReviews(ctx.UserContext(),
+		tenderId,
+		params.AuthorUsername,
+		currentUsername(ctx),
+		limit,
+		offset)
 	if err != nil {
-		slog.Errorf(path+".UpdateBidsDecision, error: {%s}", err.Error())
-		return wrapHttpError(ctx, 400, err.Error())
+		return fmt.Errorf(path+".GetBidReviews, error: {%w}", err)
 	}
 
-	resp := bidsResponse{
-		ID:              res.ID,
-		Name:            res.Title,
-		Description:     res.Description,
-		Status:          res.Status,
-		CreatorUsername: res.CreatorUsername,
-		Version:         res.Version,
-		CreatedAt:       res.CreatedAt,
+	resp := make([]api.BidReview, 0, len(res))
+	for _, v := range res {
+		resp = append(resp, api.BidReview{
+			Id:          v.ID,
+			Description: v.Description,
+			CreatedAt:   v.CreatedAt,
+		})
 	}
-	err = httpResponse(ctx, fiber.StatusOK, resp)
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
+
+func (bR *bidsRoutes) GetBidDecisions(ctx *fiber.Ctx, bidId api.BidId) error {
+	path := "internal.controller.bids.GetBidDecisions"
+
+	res, err := bR.bidsService.GetBidDecisions(ctx.UserContext(), bidId, currentUsername(ctx))
 	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
+		return fmt.Errorf(path+".GetBidDecisions, error: {%w}", err)
+	}
+
+	resp := make([]api.BidDecisionVote, 0, len(res))
+	for _, v := range res {
+		resp = append(resp, api.BidDecisionVote{
+			Id:        v.ID,
+			Username:  v.Username,
+			Decision:  api.BidDecision(v.Decision),
+			CreatedAt: v.CreatedAt,
+		})
 	}
-	return nil
+	return httpResponse(ctx, fiber.StatusOK, resp)
 }
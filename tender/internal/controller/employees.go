@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"zadanie-6105/internal/api"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/service"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+type employeeRoutes struct {
+	employeeService service.IEmployee
+}
+
+func (eR *employeeRoutes) GetEmployees(ctx *fiber.Ctx, params api.GetEmployeesParams) error {
+	path := "internal.controller.employees.GetEmployees"
+
+	limit, offset, err := pagination(params.Limit, params.Offset)
+	if err != nil {
+		return err
+	}
+	res, err := eR.employeeService.GetEmployees(ctx.UserContext(), limit, offset)
+	if err != nil {
+		return fmt.Errorf(path+".GetEmployees, error: {%w}", err)
+	}
+
+	resp := make([]api.Employee, 0, len(res))
+	for _, e := range res {
+		resp = append(resp, newEmployeeResponse(e))
+	}
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
+
+func (eR *employeeRoutes) CreateEmployee(ctx *fiber.Ctx) error {
+	path := "internal.controller.employees.CreateEmployee"
+
+	var eP api.CreateEmployeeJSONRequestBody
+	err := ctx.BodyParser(&eP)
+	if err != nil {
+		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
+		return custom_errors.ErrUnprocessableEntity
+	}
+
+	res, err := eR.employeeService.CreateEmployee(ctx.UserContext(), model.Employee{
+		Username:  eP.Username,
+		FirstName: valueOf(eP.FirstName),
+		LastName:  valueOf(eP.LastName),
+	}, eP.Password)
+	if err != nil {
+		return fmt.Errorf(path+".CreateEmployee, error: {%w}", err)
+	}
+
+	return httpResponse(ctx, fiber.StatusOK, newEmployeeResponse(res))
+}
+
+func (eR *employeeRoutes) GetEmployee(ctx *fiber.Ctx, employeeUsername api.EmployeeUsername) error {
+	path := "internal.controller.employees.GetEmployee"
+
+	res, err := eR.employeeService.GetEmployee(ctx.UserContext(), employeeUsername)
+	if err != nil {
+		// Здесь пользователь — запрашиваемый объект, а не автор запроса, поэтому его отсутствие — это 404
+		if errors.Is(err, custom_errors.ErrUserNotFound) {
+			return custom_errors.ErrEmployeeNotFound
+		}
+		return fmt.Errorf(path+".GetEmployee, error: {%w}", err)
+	}
+
+	return httpResponse(ctx, fiber.StatusOK, newEmployeeResponse(res))
+}
+
+func (eR *employeeRoutes) EditEmployee(ctx *fiber.Ctx, employeeUsername api.EmployeeUsername) error {
+	path := "internal.controller.employees.EditEmployee"
+
+	username := currentUsername(ctx)
+	var eP api.EditEmployeeJSONRequestBody
+	err := ctx.BodyParser(&eP)
+	if err != nil {
+		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
+		return custom_errors.ErrUnprocessableEntity
+	}
+
+	res, err := eR.employeeService.UpdateEmployee(ctx.UserContext(), model.Employee{
+		Username:  employeeUsername,
+		FirstName: valueOf(eP.FirstName),
+		LastName:  valueOf(eP.LastName),
+	}, username)
+	if err != nil {
+		return fmt.Errorf(path+".UpdateEmployee, error: {%w}", err)
+	}
+
+	return httpResponse(ctx, fiber.StatusOK, newEmployeeResponse(res))
+}
+
+func (eR *employeeRoutes) DeleteEmployee(ctx *fiber.Ctx, employeeUsername api.EmployeeUsername) error {
+	path := "internal.controller.employees.DeleteEmployee"
+
+	username := currentUsername(ctx)
+
+	err := eR.employeeService.DeleteEmployee(ctx.UserContext(), employeeUsername, username)
+	if err != nil {
+		return fmt.Errorf(path+".DeleteEmployee, error: {%w}", err)
+	}
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (eR *employeeRoutes) GetEmployeePermissions(ctx *fiber.Ctx, employeeUsername api.EmployeeUsername) error {
+	path := "internal.controller.employees.GetEmployeePermissions"
+
+	res, err := eR.employeeService.GetEmployeePermissions(ctx.UserContext(),
+		employeeUsername,
+		currentUsername(ctx))
+	if err != nil {
+		return fmt.Errorf(path+".GetEmployeePermissions, error: {%w}", err)
+	}
+
+	resp := make([]api.OrganizationPermissions, 0, len(res))
+	for _, p := range res {
+		permissions := make([]api.OrganizationPermissionsPermissions, 0, len(p.Permissions))
+		for _, permission := range p.Permissions {
+			permissions = append(permissions, api.OrganizationPermissionsPermissions(permission))
+		}
+		resp = append(resp, api.OrganizationPermissions{
+			OrganizationId: p.OrganizationID,
+			Role:           api.OrganizationRole(p.Role),
+			Permissions:    permissions,
+		})
+	}
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
+
+func newEmployeeResponse(e model.Employee) api.Employee {
+	return api.Employee{
+		Id:        e.ID,
+		Username:  e.Username,
+		FirstName: e.FirstName,
+		LastName:  e.LastName,
+		CreatedAt: e.CreatedAt,
+	}
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"strconv"
+	"strings"
+	"zadanie-6105/internal/api"
+	custom_errors "zadanie-6105/internal/custom-errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ETag тендера и предложения строится из номера версии: любая правка увеличивает версию,
+// поэтому совпадение версий означает совпадение представлений.
+
+func setVersionETag(ctx *fiber.Ctx, version int) {
+	ctx.Set(fiber.HeaderETag, strconv.Quote(strconv.Itoa(version)))
+}
+
+// ifMatchVersion разбирает заголовок If-Match. Без заголовка или со значением "*" возвращает 0,
+// и тогда версия не проверяется. Принимаются значения вида "3" и 3. If-Match сравнивает ETag строго
+// (RFC 9110, 13.1.1), слабый W/"3" ни с чем не совпадает, поэтому такой запрос отклоняется с 412.
+func ifMatchVersion(ifMatch *api.IfMatch) (int, error) {
+	header := strings.TrimSpace(valueOf(ifMatch))
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+	if strings.HasPrefix(header, "W/") {
+		return 0, custom_errors.ErrWeakETag
+	}
+	tag := strings.Trim(header, `"`)
+
+	version, err := strconv.Atoi(tag)
+	if err != nil || version < 1 {
+		return 0, custom_errors.ErrUnprocessableEntity
+	}
+	return version, nil
+}
+
+// versionConflict отвечает 412 Precondition Failed с актуальным представлением ресурса и его ETag.
+func versionConflict(ctx *fiber.Ctx, version int, current interface{}) error {
+	setVersionETag(ctx, version)
+	return httpResponse(ctx, fiber.StatusPreconditionFailed, current)
+}
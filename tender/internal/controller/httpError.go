@@ -2,17 +2,94 @@ package controller
 
 import (
 	"errors"
+	"strconv"
+	"strings"
+	"zadanie-6105/internal/api"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/i18n"
+	"zadanie-6105/internal/validation"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/gookit/slog"
 )
 
-func wrapHttpError(ctx *fiber.Ctx, code int, message string) error {
-	err := errors.New(message)
+const mimeProblemJSON = "application/problem+json"
+
+// kindStatus сопоставляет категории ошибок предметной области с HTTP-статусами.
+var kindStatus = map[custom_errors.Kind]int{
+	custom_errors.KindInvalid:            fiber.StatusBadRequest,
+	custom_errors.KindUnauthorized:       fiber.StatusUnauthorized,
+	custom_errors.KindForbidden:          fiber.StatusForbidden,
+	custom_errors.KindNotFound:           fiber.StatusNotFound,
+	custom_errors.KindConflict:           fiber.StatusConflict,
+	custom_errors.KindPreconditionFailed: fiber.StatusPreconditionFailed,
+}
+
+// ErrorHandler — единственное место, где ошибки превращаются в ответы. Обработчики возвращают ошибки
+// предметной области как есть или обернутыми через %w, а клиент получает application/problem+json
+// (RFC 7807) со стабильным code. Ошибки разбора параметров в сгенерированном коде и неизвестные маршруты
+// приходят как *fiber.Error и сохраняют свой код. Текст ошибки переводится на язык из Accept-Language.
+func ErrorHandler(ctx *fiber.Ctx, err error) error {
+	lang := i18n.Match(ctx.Get(fiber.HeaderAcceptLanguage))
+
+	var domainErr *custom_errors.Error
+	if errors.As(err, &domainErr) {
+		status, ok := kindStatus[domainErr.Kind]
+		if ok {
+			return problem(ctx, lang, status, domainErr.Code, i18n.T(lang, domainErr.Code), nil)
+		}
+	}
+
+	var invalid *validation.Error
+	if errors.As(err, &invalid) {
+		fields := make([]api.FieldError, 0, len(invalid.Fields))
+		for _, f := range invalid.Fields {
+			message, ok := i18n.Lookup(lang, "validation."+f.Rule, f.Args...)
+			if f.Rule == "" || !ok {
+				message = f.Message
+			}
+			fields = append(fields, api.FieldError{Field: f.Field, Message: message})
+		}
+		code := "validation_failed"
+		return problem(ctx, lang, fiber.StatusBadRequest, code, i18n.T(lang, code), &fields)
+	}
 
 	var e *fiber.Error
 	if errors.As(err, &e) {
-		code = e.Code
-		return ctx.Status(code).SendString(message)
+		// Текст *fiber.Error технический и на английском, поэтому для известных статусов отдается перевод
+		code := statusCode(e.Code)
+		detail, ok := i18n.Lookup(lang, code)
+		if !ok {
+			detail = e.Message
+		}
+		return problem(ctx, lang, e.Code, code, detail, nil)
+	}
+	slog.Errorf("internal.controller.httpError.ErrorHandler, error: {%s}", err.Error())
+	code := "internal_error"
+	return problem(ctx, lang, fiber.StatusInternalServerError, code, i18n.T(lang, code), nil)
+}
+
+// problem отвечает в формате RFC 7807. title и detail переводятся на язык ответа; для статусов без перевода
+// title остается стандартным текстом статуса. reason повторяет detail для клиентов, которые читают только его.
+func problem(ctx *fiber.Ctx, lang i18n.Lang, status int, code, detail string, fields *[]api.FieldError) error {
+	title, ok := i18n.Lookup(lang, "status."+strconv.Itoa(status))
+	if !ok {
+		title = utils.StatusMessage(status)
 	}
-	return httpResponse(ctx, code, fiber.Map{"message": message})
+	ctx.Set(fiber.HeaderContentLanguage, string(lang))
+	return ctx.Status(status).JSON(api.ErrorResponse{
+		Type:   "about:blank",
+		Title:  title,
+		Status: status,
+		Detail: detail,
+		Code:   code,
+		Reason: detail,
+		Errors: fields,
+	}, mimeProblemJSON)
+}
+
+// statusCode строит код ошибки из текста статуса: 404 -> not_found.
+func statusCode(status int) string {
+	return strings.ReplaceAll(strings.ToLower(utils.StatusMessage(status)), " ", "_")
 }
@@ -0,0 +1,211 @@
+package controller
+
+import (
+	"fmt"
+	"zadanie-6105/internal/api"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/service"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gookit/slog"
+)
+
+type organizationRoutes struct {
+	organizationService service.IOrganization
+}
+
+func (oR *organizationRoutes) GetOrganizations(ctx *fiber.Ctx, params api.GetOrganizationsParams) error {
+	path := "internal.controller.organizations.GetOrganizations"
+
+	limit, offset, err := pagination(params.Limit, params.Offset)
+	if err != nil {
+		return err
+	}
+	res, err := oR.organizationService.GetOrganizations(ctx.UserContext(), limit, offset)
+	if err != nil {
+		return fmt.Errorf(path+".GetOrganizations, error: {%w}", err)
+	}
+
+	resp := make([]api.Organization, 0, len(res))
+	for _, o := range res {
+		resp = append(resp, newOrganizationResponse(o))
+	}
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
+
+func (oR *organizationRoutes) CreateOrganization(ctx *fiber.Ctx) error {
+	path := "internal.controller.organizations.CreateOrganization"
+
+	username := currentUsername(ctx)
+	var oP api.CreateOrganizationJSONRequestBody
+	err := ctx.BodyParser(&oP)
+	if err != nil {
+		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
+		return custom_errors.ErrUnprocessableEntity
+	}
+
+	res, err := oR.organizationService.CreateOrganization(ctx.UserContext(), newOrganization(oP), username)
+	if err != nil {
+		return fmt.Errorf(path+".CreateOrganization, error: {%w}", err)
+	}
+
+	return httpResponse(ctx, fiber.StatusOK, newOrganizationResponse(res))
+}
+
+func (oR *organizationRoutes) GetOrganization(ctx *fiber.Ctx, organizationId api.OrganizationId) error {
+	path := "internal.controller.organizations.GetOrganization"
+
+	res, err := oR.organizationService.GetOrganization(ctx.UserContext(), organizationId)
+	if err != nil {
+		return fmt.Errorf(path+".GetOrganization, error: {%w}", err)
+	}
+
+	return httpResponse(ctx, fiber.StatusOK, newOrganizationResponse(res))
+}
+
+func (oR *organizationRoutes) EditOrganization(ctx *fiber.Ctx, organizationId api.OrganizationId) error {
+	path := "internal.controller.organizations.EditOrganization"
+
+	username := currentUsername(ctx)
+	var oP api.EditOrganizationJSONRequestBody
+	err := ctx.BodyParser(&oP)
+	if err != nil {
+		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
+		return custom_errors.ErrUnprocessableEntity
+	}
+
+	organization := newOrganization(oP)
+	organization.ID = organizationId
+	res, err := oR.organizationService.UpdateOrganization(ctx.UserContext(), organization, username)
+	if err != nil {
+		return fmt.Errorf(path+".UpdateOrganization, error: {%w}", err)
+	}
+
+	return httpResponse(ctx, fiber.StatusOK, newOrganizationResponse(res))
+}
+
+func (oR *organizationRoutes) DeleteOrganization(ctx *fiber.Ctx, organizationId api.OrganizationId) error {
+	path := "internal.controller.organizations.DeleteOrganization"
+
+	username := currentUsername(ctx)
+
+	err := oR.organizationService.DeleteOrganization(ctx.UserContext(), organizationId, username)
+	if err != nil {
+		return fmt.Errorf(path+".DeleteOrganization, error: {%w}", err)
+	}
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (oR *organizationRoutes) GetResponsibles(
+	ctx *fiber.Ctx,
+	organizationId api.OrganizationId,
+	params api.GetResponsiblesParams,
+) error {
+	path := "internal.controller.organizations.GetResponsibles"
+
+	limit, offset, err := pagination(params.Limit, params.Offset)
+	if err != nil {
+		return err
+	}
+
+	res, err := oR.organizationService.GetResponsibles(ctx.UserContext(), organizationId, limit, offset)
+	if err != nil {
+		return fmt.Errorf(path+".GetResponsibles, error: {%w}", err)
+	}
+
+	resp := make([]api.Responsible, 0, len(res))
+	for _, r := range res {
+		resp = append(resp, api.Responsible{
+			Id:        r.Employee.ID,
+			Username:  r.Employee.Username,
+			FirstName: r.Employee.FirstName,
+			LastName:  r.Employee.LastName,
+			CreatedAt: r.Employee.CreatedAt,
+			Role:      api.OrganizationRole(r.Role),
+		})
+	}
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
+
+func (oR *organizationRoutes) AddResponsible(
+	ctx *fiber.Ctx,
+	organizationId api.OrganizationId,
+	employeeUsername api.EmployeeUsername,
+	params api.AddResponsibleParams,
+) error {
+	path := "internal.controller.organizations.AddResponsible"
+
+	username := currentUsername(ctx)
+
+	// Без явной роли новый участник получает минимальные права
+	role := model.OrganizationRoleViewer
+	if params.Role != nil {
+		role = model.OrganizationRole(*params.Role)
+	}
+
+	err := oR.organizationService.AddResponsible(ctx.UserContext(),
+		organizationId,
+		employeeUsername,
+		role,
+		username)
+	if err != nil {
+		return fmt.Errorf(path+".AddResponsible, error: {%w}", err)
+	}
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (oR *organizationRoutes) UpdateResponsibleRole(
+	ctx *fiber.Ctx,
+	organizationId api.OrganizationId,
+	employeeUsername api.EmployeeUsername,
+	params api.UpdateResponsibleRoleParams,
+) error {
+	path := "internal.controller.organizations.UpdateResponsibleRole"
+
+	username := currentUsername(ctx)
+
+	err := oR.organizationService.UpdateResponsibleRole(ctx.UserContext(),
+		organizationId,
+		employeeUsername,
+		model.OrganizationRole(params.Role),
+		username)
+	if err != nil {
+		return fmt.Errorf(path+".UpdateResponsibleRole, error: {%w}", err)
+	}
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (oR *organizationRoutes) RemoveResponsible(
+	ctx *fiber.Ctx,
+	organizationId api.OrganizationId,
+	employeeUsername api.EmployeeUsername,
+) error {
+	path := "internal.controller.organizations.RemoveResponsible"
+
+	username := currentUsername(ctx)
+
+	err := oR.organizationService.RemoveResponsible(ctx.UserContext(), organizationId, employeeUsername, username)
+	if err != nil {
+		return fmt.Errorf(path+".RemoveResponsible, error: {%w}", err)
+	}
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func newOrganization(oP api.OrganizationParams) model.Organization {
+	return model.Organization{
+		Name:        valueOf(oP.Name),
+		Description: valueOf(oP.Description),
+		Type:        model.OrganizationType(valueOf(oP.Type)),
+	}
+}
+
+func newOrganizationResponse(o model.Organization) api.Organization {
+	return api.Organization{
+		Id:          o.ID,
+		Name:        o.Name,
+		Description: o.Description,
+		Type:        api.OrganizationType(o.Type),
+		CreatedAt:   o.CreatedAt,
+	}
+}
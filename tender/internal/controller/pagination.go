@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+	"zadanie-6105/internal/api"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultLimit = 5
+	maxLimit     = 50
+
+	headerNextCursor = "X-Next-Cursor"
+	headerTotalCount = "X-Total-Count"
+)
+
+// pagination проверяет limit и offset из сгенерированных параметров и подставляет значения по умолчанию.
+func pagination(limit *api.PaginationLimit, offset *api.PaginationOffset) (int, int, error) {
+	l, o := defaultLimit, 0
+	if limit != nil {
+		if *limit < 0 || *limit > maxLimit {
+			return 0, 0, custom_errors.ErrUnprocessableEntity
+		}
+		l = int(*limit)
+	}
+	if offset != nil {
+		if *offset < 0 {
+			return 0, 0, custom_errors.ErrUnprocessableEntity
+		}
+		o = int(*offset)
+	}
+	return l, o, nil
+}
+
+// keysetPagination собирает страницу для списков с курсором. Если передан cursor, offset не учитывается.
+func keysetPagination(
+	limit *api.PaginationLimit,
+	offset *api.PaginationOffset,
+	cursor *api.PaginationCursor,
+	total *api.PaginationTotal,
+) (model.Page, error) {
+	l, o, err := pagination(limit, offset)
+	if err != nil {
+		return model.Page{}, err
+	}
+	page := model.Page{Limit: l, Offset: o, WithTotal: valueOf(total)}
+	if cursor != nil {
+		after, err := decodeCursor(*cursor)
+		if err != nil {
+			return model.Page{}, custom_errors.ErrUnprocessableEntity
+		}
+		page.After = &after
+	}
+	return page, nil
+}
+
+// pageBody — тело страницы с envelope=true, общее для api.TenderPage и api.BidPage.
+type pageBody[R any] struct {
+	Items      []R     `json:"items"`
+	NextCursor *string `json:"nextCursor,omitempty"`
+	Total      *int    `json:"total,omitempty"`
+}
+
+// pageResponse отвечает страницей списка. Курсор следующей страницы и, если его запросили, общее число
+// объектов всегда передаются в заголовках, а с envelope=true еще и в теле вокруг массива items.
+// Без envelope тело остается массивом, чтобы не ломать клиентов, которые пагинацию не используют.
+func pageResponse[T, R any](ctx *fiber.Ctx, list model.List[T], items []R, envelope *api.PaginationEnvelope) error {
+	body := pageBody[R]{Items: items, Total: list.Total}
+	if list.Next != nil {
+		cursor := encodeCursor(*list.Next)
+		body.NextCursor = &cursor
+		ctx.Set(headerNextCursor, cursor)
+	}
+	if list.Total != nil {
+		ctx.Set(headerTotalCount, strconv.Itoa(*list.Total))
+	}
+	if !valueOf(envelope) {
+		return httpResponse(ctx, fiber.StatusOK, items)
+	}
+	return httpResponse(ctx, fiber.StatusOK, body)
+}
+
+// encodeCursor упаковывает позицию в непрозрачную для клиента строку: время создания и id через
+// пробел в base64url без выравнивания.
+func encodeCursor(c model.Cursor) string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + " " + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(s string) (model.Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return model.Cursor{}, err
+	}
+	createdAt, id, ok := strings.Cut(string(raw), " ")
+	if !ok {
+		return model.Cursor{}, custom_errors.ErrUnprocessableEntity
+	}
+	var c model.Cursor
+	c.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return model.Cursor{}, err
+	}
+	c.ID, err = uuid.Parse(id)
+	if err != nil {
+		return model.Cursor{}, err
+	}
+	return c, nil
+}
+
+// sortFields разбирает параметр sort вида -createdAt,name. Допустимые поля проверяются по спецификации
+// и еще раз по белому списку в репозитории.
+func sortFields(sort string) []model.SortField {
+	var fields []model.SortField
+	for _, field := range strings.Split(sort, ",") {
+		if field == "" {
+			continue
+		}
+		name, desc := strings.CutPrefix(field, "-")
+		fields = append(fields, model.SortField{Field: name, Desc: desc})
+	}
+	return fields
+}
+
+// valueOf возвращает значение необязательного поля запроса или нулевое значение, если поле не передано.
+func valueOf[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
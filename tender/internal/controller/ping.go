@@ -4,14 +4,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-type PingRoutes struct{}
+type pingRoutes struct{}
 
-func newPingRoutes(g fiber.Router) {
-	aR := &tenderRoutes{}
-
-	g.Get("/", aR.ping)
-}
-
-func (tR *tenderRoutes) ping(c *fiber.Ctx) error {
+func (pR *pingRoutes) CheckServer(c *fiber.Ctx) error {
 	return c.SendString("ok")
 }
@@ -1,16 +1,80 @@
 package controller
 
 import (
+	"fmt"
+	"regexp"
+	"zadanie-6105/internal/api"
 	"zadanie-6105/internal/service"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gofiber/fiber/v2"
 )
 
-func NewRouter(app *fiber.App, services *service.Services) {
-	tenders := app.Group("/api/tenders")
-	newTenderRoutes(tenders, services.ITender)
-	ping := app.Group("/api/ping")
-	newPingRoutes(ping)
-	bids := app.Group("/api/bids")
-	newBidsRoutes(bids, services.IBids)
+const baseURL = "/api"
+
+// server собирает обработчики всех групп маршрутов в реализацию сгенерированного api.ServerInterface.
+type server struct {
+	*authRoutes
+	*pingRoutes
+	*tenderRoutes
+	*bidsRoutes
+	*organizationRoutes
+	*employeeRoutes
+	*searchRoutes
+}
+
+var _ api.ServerInterface = (*server)(nil)
+
+func NewRouter(app *fiber.App, services *service.Services, authDevMode bool) error {
+	path := "internal.controller.router.NewRouter"
+
+	authenticator := &authMiddleware{
+		authService:     services.IAuth,
+		employeeService: services.IEmployee,
+		devMode:         authDevMode,
+	}
+	app.Use(baseURL, authenticator.authenticate)
+
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		return fmt.Errorf(path+".GetSwagger, error: {%s}", err.Error())
+	}
+	requireAuthentication(app, swagger)
+	validateRequests(app, swagger)
+
+	api.RegisterHandlersWithOptions(app, &server{
+		authRoutes:         &authRoutes{authService: services.IAuth},
+		pingRoutes:         &pingRoutes{},
+		tenderRoutes:       &tenderRoutes{tenderService: services.ITender},
+		bidsRoutes:         &bidsRoutes{bidsService: services.IBids},
+		organizationRoutes: &organizationRoutes{organizationService: services.IOrganization},
+		employeeRoutes:     &employeeRoutes{employeeService: services.IEmployee},
+		searchRoutes:       &searchRoutes{searchService: services.ISearch},
+	}, api.FiberServerOptions{BaseURL: baseURL})
+	return nil
+}
+
+var pathParam = regexp.MustCompile(`\{(\w+)\}`)
+
+// requireAuthentication ставит requireEmployee перед операциями, для которых спецификация не допускает
+// анонимный доступ. Сгенерированные обертки одинаково помечают любые операции с bearerAuth,
+// поэтому закрытые операции перечисляются в openapi.yml явным security без пустого требования.
+func requireAuthentication(app *fiber.App, swagger *openapi3.T) {
+	for route, item := range swagger.Paths.Map() {
+		for method, operation := range item.Operations() {
+			if operation.Security == nil || allowsAnonymous(*operation.Security) {
+				continue
+			}
+			app.Add(method, baseURL+pathParam.ReplaceAllString(route, ":$1"), requireEmployee)
+		}
+	}
+}
+
+func allowsAnonymous(requirements openapi3.SecurityRequirements) bool {
+	for _, requirement := range requirements {
+		if len(requirement) == 0 {
+			return true
+		}
+	}
+	return len(requirements) == 0
 }
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"zadanie-6105/internal/api"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/service"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type searchRoutes struct {
+	searchService service.ISearch
+}
+
+// highlight экранирует сниппет как HTML и только затем заменяет служебные границы совпадений на <mark>,
+// чтобы разметка из названия или описания тендера не попала на страницу клиента.
+var highlight = strings.NewReplacer(model.HighlightStart, "<mark>", model.HighlightStop, "</mark>")
+
+func newTenderSearchResponse(results []model.TenderSearchResult) []api.TenderSearchResult {
+	resp := make([]api.TenderSearchResult, 0, len(results))
+	for _, r := range results {
+		resp = append(resp, api.TenderSearchResult{
+			Tender:  newTenderResponse(r.Tender),
+			Rank:    r.Rank,
+			Snippet: highlight.Replace(html.EscapeString(r.Snippet)),
+		})
+	}
+	return resp
+}
+
+func (sR *searchRoutes) SearchTenders(ctx *fiber.Ctx, params api.SearchTendersParams) error {
+	path := "internal.controller.search.SearchTenders"
+
+	limit, offset, err := pagination(params.Limit, params.Offset)
+	if err != nil {
+		return err
+	}
+	var serviceTypes []string
+	for _, serviceType := range valueOf(params.ServiceType) {
+		serviceTypes = append(serviceTypes, string(serviceType))
+	}
+	results, err := sR.searchService.SearchTenders(ctx.UserContext(), currentUsername(ctx), model.TenderSearch{
+		Query:        params.Q,
+		ServiceTypes: serviceTypes,
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		return fmt.Errorf(path+".SearchTenders, error: {%w}", err)
+	}
+	return httpResponse(ctx, fiber.StatusOK, newTenderSearchResponse(results))
+}
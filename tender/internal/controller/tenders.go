@@ -3,15 +3,14 @@ package controller
 import (
 	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
+	"zadanie-6105/internal/api"
 	custom_errors "zadanie-6105/internal/custom-errors"
 	"zadanie-6105/internal/model"
 	"zadanie-6105/internal/service"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/google/uuid"
 	"github.com/gookit/slog"
 )
 
@@ -19,332 +18,283 @@ type tenderRoutes struct {
 	tenderService service.ITender
 }
 
-func newTenderRoutes(g fiber.Router, tenderService service.ITender) {
-	aR := &tenderRoutes{tenderService: tenderService}
-
-	g.Get("/", aR.tenders)
-	g.Post("/new", aR.tendersNew)
-	g.Get("/my", aR.my)
-	g.Patch("/:tenderId/edit", aR.edit)
-	g.Get("/:tenderId/status", aR.status)
-	g.Put("/:tenderId/status", aR.editStatus)
+func newTenderResponse(t model.Tender) api.Tender {
+	resp := api.Tender{
+		Id:                 t.ID,
+		Name:               t.Title,
+		Description:        t.Description,
+		ServiceType:        api.TenderServiceType(t.ServiceType),
+		Status:             api.TenderStatus(t.Status),
+		OrganizationId:     t.OrganizationID,
+		Version:            api.TenderVersion(t.Version),
+		CreatedAt:          t.CreatedAt,
+		SubmissionDeadline: t.SubmissionDeadline,
+		PublishAt:          t.PublishAt,
+	}
+	if t.ClosedBy != "" {
+		resp.ClosedBy = &t.ClosedBy
+	}
+	return resp
 }
 
-type tendersResponse struct {
-	Tenders []tenderResponse `json:"tenders"`
-}
-type tenderResponse struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	ServiceType string    `json:"serviceType"`
-	Status      string    `json:"status"`
-	Version     int       `json:"version"`
-	CreatedAt   time.Time `json:"created_at"`
-}
-type tenderParams struct {
-	Tender model.Tender `json:"tender"`
+// utcTime приводит срок или время публикации к UTC, чтобы в базе и в ответах они не зависели
+// от часового пояса клиента.
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
 }
 
-func (tR *tenderRoutes) tenders(ctx *fiber.Ctx) error {
-	path := "controller.tenders.tenders"
-	m := ctx.Queries()
+func newTendersResponse(tenders []model.Tender) []api.Tender {
+	resp := make([]api.Tender, 0, len(tenders))
+	for _, t := range tenders {
+		resp = append(resp, newTenderResponse(t))
+	}
+	return resp
+}
 
-	limitStr := m["limit"]
-	var limitInt int
-	var err error
+func (tR *tenderRoutes) GetTenders(ctx *fiber.Ctx, params api.GetTendersParams) error {
+	path := "controller.tenders.GetTenders"
 
-	if limitStr != "" {
-		limitInt, err = strconv.Atoi(limitStr)
-		if err != nil {
-			slog.Errorf(path+".Atoi, error: {%s}", err)
-			return wrapHttpError(ctx, 400, "Invalid limit parameter")
-		}
-	} else {
-		limitInt = 5
+	page, err := keysetPagination(params.Limit, params.Offset, params.Cursor, params.Total)
+	if err != nil {
+		return err
 	}
-	offsetStr := m["offset"]
-	var offsetInt int
-
-	if offsetStr != "" {
-		offsetInt, err = strconv.Atoi(offsetStr)
-		if err != nil {
-			slog.Errorf(path+".Atoi, error: {%s}", err)
-			return wrapHttpError(ctx, 400, "Invalid offset parameter")
-		}
-	} else {
-		offsetInt = 0
+	filter := model.TenderFilter{
+		OrganizationID:  valueOf(params.OrganizationId),
+		CreatedFrom:     valueOf(utcTime(params.CreatedFrom)),
+		CreatedTo:       valueOf(utcTime(params.CreatedTo)),
+		Version:         int(valueOf(params.Version)),
+		CreatorUsername: valueOf(params.Creator),
+		Sort:            sortFields(valueOf(params.Sort)),
 	}
-	serviceTypes := m["serviceTypes"]
-	var serviceTypesArr []string
-	if serviceTypes != "" {
-		serviceTypesArr = strings.Split(serviceTypes, ",") // Разделение по запятым
+	if len(filter.Sort) > 0 && page.After != nil {
+		return custom_errors.ErrUnprocessableEntity
 	}
-	tenders, err := tR.tenderService.GetTenders(ctx.Context(), limitInt, offsetInt, serviceTypesArr)
-	if err != nil {
-		slog.Errorf(path+".Scan, error: {%s}", err)
-		if errors.Is(err, custom_errors.ErrTenderNotFound) {
-			return wrapHttpError(ctx, 400, err.Error())
+	// service_type повторяется и проверяется по перечислению спецификации; serviceTypes через запятую
+	// оставлен для старых клиентов
+	for _, serviceType := range valueOf(params.ServiceType) {
+		filter.ServiceTypes = append(filter.ServiceTypes, string(serviceType))
+	}
+	for _, value := range ctx.Context().QueryArgs().PeekMulti("serviceTypes") {
+		for _, serviceType := range strings.Split(string(value), ",") {
+			if serviceType != "" {
+				filter.ServiceTypes = append(filter.ServiceTypes, serviceType)
+			}
 		}
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, err.Error())
 	}
-	resp := tendersResponse{}
-
-	for _, t := range tenders {
-		resp.Tenders = append(resp.Tenders, tenderResponse{
-			ID:          t.ID,
-			Name:        t.Title,
-			Description: t.Description,
-			ServiceType: t.ServiceType,
-			Status:      t.Status,
-			Version:     t.Version,
-			CreatedAt:   t.CreatedAt,
-		})
+	for _, status := range valueOf(params.Status) {
+		filter.Statuses = append(filter.Statuses, string(status))
 	}
-	err = httpResponse(ctx, fiber.StatusOK, resp)
+	tenders, err := tR.tenderService.GetTenders(ctx.UserContext(), currentUsername(ctx), page, filter)
 	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
+		return fmt.Errorf(path+".GetTenders, error: {%w}", err)
 	}
-
-	return nil
+	return pageResponse(ctx, tenders, newTendersResponse(tenders.Items), params.Envelope)
 }
 
-func (tR *tenderRoutes) tendersNew(ctx *fiber.Ctx) error {
-	path := "controller.tenders.tendersNew"
-
-	var tP tenderParams
+func (tR *tenderRoutes) CreateTender(ctx *fiber.Ctx) error {
+	path := "controller.tenders.CreateTender"
 
-	err := ctx.BodyParser(&tP.Tender)
+	var body api.CreateTenderJSONRequestBody
+	err := ctx.BodyParser(&body)
 	if err != nil {
 		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
-		return wrapHttpError(ctx, 500, "internal error")
+		return custom_errors.ErrUnprocessableEntity
 	}
-	tP.Tender.Status = "Created"
-	tender, err := tR.tenderService.CreateTender(ctx.Context(), tP.Tender)
+	tender, err := tR.tenderService.CreateTender(ctx.UserContext(), model.Tender{
+		OrganizationID: body.OrganizationId,
+		Title:          body.Name,
+		Description:    body.Description,
+		ServiceType:    string(body.ServiceType),
+		// Автором тендера всегда становится пользователь, прошедший аутентификацию
+		CreatorUsername:    currentUsername(ctx),
+		SubmissionDeadline: utcTime(body.SubmissionDeadline),
+		PublishAt:          utcTime(body.PublishAt),
+	})
 	if err != nil {
-		if errors.Is(err, custom_errors.ErrAccessDenied) {
-			return wrapHttpError(ctx, 403, custom_errors.ErrAccessDenied.Error())
-		}
-		if errors.Is(err, custom_errors.ErrTenderAlreadyExists) {
-			return wrapHttpError(ctx, 401, custom_errors.ErrTenderAlreadyExists.Error())
-		}
-		slog.Errorf(path+".CreateTender, error: {%s}", err.Error())
-		return err
-	}
-	resp := tenderResponse{
-		ID:          tender.ID,
-		Name:        tender.Title,
-		Description: tender.Description,
-		ServiceType: tender.ServiceType,
-		Status:      tender.Status,
-		Version:     tender.Version,
-		CreatedAt:   tender.CreatedAt,
+		return fmt.Errorf(path+".CreateTender, error: {%w}", err)
 	}
+	resp := newTenderResponse(tender)
+	setVersionETag(ctx, tender.Version)
 
-	err = httpResponse(ctx, fiber.StatusOK, resp)
-	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
-	}
-	return nil
+	return httpResponse(ctx, fiber.StatusOK, resp)
 }
 
-func (tR *tenderRoutes) my(ctx *fiber.Ctx) error {
-	path := "controller.tenders.tendersNew"
-	m := ctx.Queries()
-
-	limitStr := m["limit"]
-	var limitInt int
-	var err error
+func (tR *tenderRoutes) GetUserTenders(ctx *fiber.Ctx, params api.GetUserTendersParams) error {
+	path := "controller.tenders.GetUserTenders"
 
-	if limitStr != "" {
-		limitInt, err = strconv.Atoi(limitStr)
-		if err != nil {
-			slog.Errorf(path+".Atoi, error: {%s}", err)
-			return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-		}
-	} else {
-		limitInt = 5
-	}
-	offsetStr := m["offset"]
-	var offsetInt int
-
-	if offsetStr != "" {
-		offsetInt, err = strconv.Atoi(offsetStr)
-		if err != nil {
-			slog.Errorf(path+".Atoi, error: {%s}", err)
-			return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-		}
-	} else {
-		offsetInt = 0
-	}
-	user := ctx.Queries()
-	tenders, err := tR.tenderService.GetTender(ctx.Context(), user["username"], limitInt, offsetInt)
+	page, err := keysetPagination(params.Limit, params.Offset, params.Cursor, params.Total)
 	if err != nil {
-		if errors.Is(err, custom_errors.ErrTenderNotFound) || len(tenders) == 0 {
-			return wrapHttpError(ctx, 401, custom_errors.ErrTenderNotFound.Error())
-		}
-		slog.Errorf(path+".GetTender, error: {%s}", err)
 		return err
 	}
-	resp := tendersResponse{}
-
-	for _, t := range tenders {
-		resp.Tenders = append(resp.Tenders, tenderResponse{
-			ID:          t.ID,
-			Name:        t.Title,
-			Description: t.Description,
-			ServiceType: t.ServiceType,
-			Status:      t.Status,
-			Version:     t.Version,
-			CreatedAt:   t.CreatedAt,
-		})
-	}
-	err = httpResponse(ctx, fiber.StatusOK, resp)
+	tenders, err := tR.tenderService.GetTender(ctx.UserContext(), currentUsername(ctx), page)
 	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
+		return fmt.Errorf(path+".GetTender, error: {%w}", err)
 	}
-	return nil
+	return pageResponse(ctx, tenders, newTendersResponse(tenders.Items), params.Envelope)
 }
 
-func (tR *tenderRoutes) edit(ctx *fiber.Ctx) error {
-	path := "controller.tenders.edit"
+func (tR *tenderRoutes) EditTender(ctx *fiber.Ctx, tenderId api.TenderId, params api.EditTenderParams) error {
+	path := "controller.tenders.EditTender"
 
-	var tP tenderParams
-	username := ctx.Query("username")
-	tP.Tender.CreatorUsername = username
-	if tP.Tender.CreatorUsername == "" {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-	}
-	tenderIdStr := ctx.Params("tenderId")
-
-	parsedID, err := uuid.Parse(tenderIdStr)
+	var body api.EditTenderJSONRequestBody
+	err := ctx.BodyParser(&body)
 	if err != nil {
-		return wrapHttpError(ctx, 400, "Invalid tenderId format")
+		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
+		return custom_errors.ErrUnprocessableEntity
 	}
-	tP.Tender.ID = parsedID
-	err = ctx.BodyParser(&tP.Tender)
+	expectedVersion, err := ifMatchVersion(params.IfMatch)
 	if err != nil {
-		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
-		return wrapHttpError(ctx, 500, "internal error")
+		return err
 	}
-	res, err := tR.tenderService.UpdateTender(ctx.Context(), tP.Tender)
+	res, err := tR.tenderService.UpdateTender(ctx.UserContext(), model.Tender{
+		ID:                 tenderId,
+		Title:              valueOf(body.Name),
+		Description:        valueOf(body.Description),
+		ServiceType:        string(valueOf(body.ServiceType)),
+		Version:            expectedVersion,
+		CreatorUsername:    currentUsername(ctx),
+		SubmissionDeadline: utcTime(body.SubmissionDeadline),
+	})
 	if err != nil {
-		slog.Errorf(path+".UpdateTender, error: {%s}", err.Error())
-
-		if errors.Is(err, custom_errors.ErrTenderNotFound) {
-			return wrapHttpError(ctx, 404, custom_errors.ErrTenderNotFound.Error())
-		}
-
-		if errors.Is(err, custom_errors.ErrUserNotFound) {
-			return wrapHttpError(ctx, 400, custom_errors.ErrUserNotFound.Error())
-		}
-		if errors.Is(err, custom_errors.ErrAccessDenied) {
-			return wrapHttpError(ctx, 403, custom_errors.ErrAccessDenied.Error())
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return versionConflict(ctx, res.Version, newTenderResponse(res))
 		}
-		if errors.Is(err, custom_errors.ErrUnprocessableEntity) {
-			return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-		}
-		return err
+		return fmt.Errorf(path+".UpdateTender, error: {%w}", err)
 	}
 
-	resp := tenderResponse{
-		ID:          res.ID,
-		Name:        res.Title,
-		Description: res.Description,
-		ServiceType: res.ServiceType,
-		Status:      res.Status,
-		Version:     res.Version,
-		CreatedAt:   res.CreatedAt,
-	}
-	err = httpResponse(ctx, fiber.StatusOK, resp)
+	resp := newTenderResponse(res)
+	setVersionETag(ctx, res.Version)
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
+
+func (tR *tenderRoutes) GetTenderStatus(ctx *fiber.Ctx, tenderId api.TenderId) error {
+	path := "controller.tenders.GetTenderStatus"
+
+	res, err := tR.tenderService.GetStatus(ctx.UserContext(), tenderId, currentUsername(ctx))
 	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
+		return fmt.Errorf(path+".GetStatus, error: {%w}", err)
 	}
-	return nil
+	resp := res
+	return httpResponse(ctx, fiber.StatusOK, resp)
 }
 
-func (tR *tenderRoutes) status(ctx *fiber.Ctx) error {
-	path := "controller.tenders.status"
+func (tR *tenderRoutes) UpdateTenderStatus(
+	ctx *fiber.Ctx,
+	tenderId api.TenderId,
+	params api.UpdateTenderStatusParams,
+) error {
+	path := "internal.controller.tenders.UpdateTenderStatus"
 
-	tenderIdStr := ctx.Params("tenderId")
-	tenderId, err := uuid.Parse(tenderIdStr)
-	if err != nil {
-		return wrapHttpError(ctx, 400, "Invalid tenderId format")
+	if params.Status == "" {
+		return custom_errors.ErrUnprocessableEntity
 	}
-	res, err := tR.tenderService.GetStatus(ctx.Context(), tenderId)
+	expectedVersion, err := ifMatchVersion(params.IfMatch)
 	if err != nil {
-		if errors.Is(err, custom_errors.ErrTenderNotFound) {
-			return wrapHttpError(ctx, 404, custom_errors.ErrTenderNotFound.Error())
-		}
-		slog.Errorf(path+".GetStatus, error: {%s}", err.Error())
 		return err
 	}
-	resp := res
-	err = httpResponse(ctx, fiber.StatusOK, resp)
+
+	res, err := tR.tenderService.UpdateStatus(ctx.UserContext(), model.Tender{
+		ID:              tenderId,
+		Status:          string(params.Status),
+		Version:         expectedVersion,
+		CreatorUsername: currentUsername(ctx),
+	})
 	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return versionConflict(ctx, res.Version, newTenderResponse(res))
+		}
+		return fmt.Errorf(path+".UpdateStatus, error: {%w}", err)
 	}
-	return nil
+	resp := newTenderResponse(res)
+	setVersionETag(ctx, res.Version)
+
+	return httpResponse(ctx, fiber.StatusOK, resp)
 }
 
-func (tR *tenderRoutes) editStatus(ctx *fiber.Ctx) error {
-	path := "internal.controller.tenders.editStatus"
-	var tP tenderParams
+func (tR *tenderRoutes) RollbackTender(
+	ctx *fiber.Ctx,
+	tenderId api.TenderId,
+	version int32,
+	params api.RollbackTenderParams,
+) error {
+	path := "internal.controller.tenders.RollbackTender"
 
-	username := ctx.Query("username")
-	tP.Tender.CreatorUsername = username
-	if tP.Tender.CreatorUsername == "" {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
+	if version < 1 {
+		return custom_errors.ErrUnprocessableEntity
 	}
-	tenderIdStr := ctx.Params("tenderId")
-	if tenderIdStr == "" {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
+	expectedVersion, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return err
 	}
 
-	parsedID, err := uuid.Parse(tenderIdStr)
+	res, err := tR.tenderService.RollbackTender(ctx.UserContext(), model.Tender{
+		ID:              tenderId,
+		Version:         expectedVersion,
+		CreatorUsername: currentUsername(ctx),
+	}, int(version))
 	if err != nil {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return versionConflict(ctx, res.Version, newTenderResponse(res))
+		}
+		return fmt.Errorf(path+".RollbackTender, error: {%w}", err)
 	}
-	tP.Tender.ID = parsedID
+	resp := newTenderResponse(res)
+	setVersionETag(ctx, res.Version)
 
-	status := ctx.Query("status")
-	if status == "" {
-		return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
+	return httpResponse(ctx, fiber.StatusOK, resp)
+}
+
+func newPublicationResponse(p model.TenderPublication) api.TenderPublication {
+	resp := api.TenderPublication{
+		TenderId:  p.TenderID,
+		PublishAt: p.PublishAt,
+		Status:    api.TenderPublicationStatus(p.Status),
+		CreatedBy: p.CreatedBy,
+		UpdatedAt: p.UpdatedAt,
+	}
+	if p.Error != "" {
+		resp.Error = &p.Error
 	}
-	tP.Tender.Status = status
+	return resp
+}
+
+func (tR *tenderRoutes) GetTenderPublication(ctx *fiber.Ctx, tenderId api.TenderId) error {
+	path := "internal.controller.tenders.GetTenderPublication"
 
-	res, err := tR.tenderService.UpdateStatus(ctx.Context(), tP.Tender)
+	res, err := tR.tenderService.GetPublication(ctx.UserContext(), tenderId, currentUsername(ctx))
 	if err != nil {
-		slog.Errorf(path+".UpdateStatus, error: {%s}", err.Error())
+		return fmt.Errorf(path+".GetPublication, error: {%w}", err)
+	}
+	return httpResponse(ctx, fiber.StatusOK, newPublicationResponse(res))
+}
 
-		if errors.Is(err, custom_errors.ErrTenderNotFound) {
-			return wrapHttpError(ctx, 404, custom_errors.ErrTenderNotFound.Error())
-		}
+func (tR *tenderRoutes) ScheduleTenderPublication(ctx *fiber.Ctx, tenderId api.TenderId) error {
+	path := "internal.controller.tenders.ScheduleTenderPublication"
 
-		if errors.Is(err, custom_errors.ErrUserNotFound) {
-			return wrapHttpError(ctx, 400, custom_errors.ErrUserNotFound.Error())
-		}
-		if errors.Is(err, custom_errors.ErrAccessDenied) {
-			return wrapHttpError(ctx, 403, custom_errors.ErrAccessDenied.Error())
-		}
-		if errors.Is(err, custom_errors.ErrUnprocessableEntity) {
-			return wrapHttpError(ctx, 400, custom_errors.ErrUnprocessableEntity.Error())
-		}
-		return wrapHttpError(ctx, 500, "Internal server error")
+	var body api.ScheduleTenderPublicationJSONRequestBody
+	err := ctx.BodyParser(&body)
+	if err != nil {
+		slog.Errorf(fmt.Errorf(path+".BodyParser, error: {%s}", err).Error())
+		return custom_errors.ErrUnprocessableEntity
 	}
-	resp := tenderResponse{
-		ID:          res.ID,
-		Name:        res.Title,
-		Description: res.Description,
-		ServiceType: res.ServiceType,
-		Status:      res.Status,
-		Version:     res.Version,
-		CreatedAt:   res.CreatedAt,
+	publishAt := body.PublishAt.UTC()
+	res, err := tR.tenderService.SchedulePublication(ctx.UserContext(), tenderId, publishAt, currentUsername(ctx))
+	if err != nil {
+		return fmt.Errorf(path+".SchedulePublication, error: {%w}", err)
 	}
+	return httpResponse(ctx, fiber.StatusOK, newPublicationResponse(res))
+}
+
+func (tR *tenderRoutes) CancelTenderPublication(ctx *fiber.Ctx, tenderId api.TenderId) error {
+	path := "internal.controller.tenders.CancelTenderPublication"
 
-	err = httpResponse(ctx, fiber.StatusOK, resp)
+	res, err := tR.tenderService.CancelPublication(ctx.UserContext(), tenderId, currentUsername(ctx))
 	if err != nil {
-		return wrapHttpError(ctx, fiber.StatusInternalServerError, "internal server error")
+		return fmt.Errorf(path+".CancelPublication, error: {%w}", err)
 	}
-	return nil
+	return httpResponse(ctx, fiber.StatusOK, newPublicationResponse(res))
 }
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"fmt"
+	"zadanie-6105/internal/validation"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+)
+
+// validateRequests ставит перед каждой операцией проверку запроса по openapi.yml. Регистрируется после
+// requireAuthentication, чтобы анонимный запрос к закрытой операции получал 401, а не 400.
+func validateRequests(app *fiber.App, swagger *openapi3.T) {
+	validator := validation.New()
+	for route, item := range swagger.Paths.Map() {
+		for method, operation := range item.Operations() {
+			r := &routers.Route{
+				Spec:      swagger,
+				Path:      route,
+				PathItem:  item,
+				Method:    method,
+				Operation: operation,
+			}
+			app.Add(method, baseURL+pathParam.ReplaceAllString(route, ":$1"), validateRequest(validator, r))
+		}
+	}
+}
+
+func validateRequest(validator *validation.Validator, route *routers.Route) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		path := "internal.controller.validation.validateRequest"
+
+		req, err := adaptor.ConvertRequest(ctx, false)
+		if err != nil {
+			return fmt.Errorf(path+".ConvertRequest, error: {%s}", err.Error())
+		}
+		err = validator.Request(ctx.UserContext(), route, req, ctx.AllParams())
+		if err != nil {
+			return err
+		}
+		return ctx.Next()
+	}
+}
@@ -1,13 +1,69 @@
 package custom_errors
 
-import "errors"
+import "zadanie-6105/internal/i18n"
+
+// Kind — категория ошибки предметной области. По ней контроллер выбирает HTTP-статус,
+// сервисы и репозитории о протоколе не знают.
+type Kind int
+
+const (
+	KindInvalid Kind = iota + 1
+	KindUnauthorized
+	KindForbidden
+	KindNotFound
+	KindConflict
+	KindPreconditionFailed
+)
+
+// Error — ошибка предметной области. Code — стабильный машиночитаемый код, на который опираются
+// клиенты; он же ключ текста ошибки в каталоге i18n.
+type Error struct {
+	Kind Kind
+	Code string
+}
+
+// Error возвращает текст на языке по умолчанию; перевод для клиента выбирает контроллер.
+func (e *Error) Error() string {
+	return i18n.T(i18n.Default, e.Code)
+}
+
+func newError(kind Kind, code string) *Error {
+	return &Error{Kind: kind, Code: code}
+}
 
 var (
-	ErrTenderAlreadyExists = errors.New("такой тендер уже существует")
-	ErrTenderNotFound      = errors.New("тендер не найден")
-	ErrBidsNotFound        = errors.New("предложения не найдены")
-	ErrUnprocessableEntity = errors.New("неправильные данные")
-	ErrBidsAlreadyExists   = errors.New("предложение уже существует")
-	ErrAccessDenied        = errors.New("у вас недостаточно прав")
-	ErrUserNotFound        = errors.New("пользователь не найден")
+	ErrTenderAlreadyExists = newError(KindConflict, "tender_already_exists")
+	ErrTenderNotFound      = newError(KindNotFound, "tender_not_found")
+	ErrBidsNotFound        = newError(KindNotFound, "bid_not_found")
+	ErrUnprocessableEntity = newError(KindInvalid, "invalid_request")
+	ErrBidsAlreadyExists   = newError(KindConflict, "bid_already_exists")
+	ErrAccessDenied        = newError(KindForbidden, "access_denied")
+	ErrUserNotFound        = newError(KindUnauthorized, "user_not_found")
+	ErrVersionNotFound     = newError(KindNotFound, "version_not_found")
+	ErrFeedbackNotFound    = newError(KindNotFound, "feedback_not_found")
+	ErrDecisionAlreadyMade = newError(KindConflict, "decision_already_made")
+	ErrBidNotPublished     = newError(KindInvalid, "bid_not_published")
+	ErrTenderClosed        = newError(KindInvalid, "tender_closed")
+
+	ErrSubmissionDeadlinePassed = newError(KindInvalid, "submission_deadline_passed")
+	ErrInvalidDeadline          = newError(KindInvalid, "invalid_submission_deadline")
+	ErrInvalidPublishAt         = newError(KindInvalid, "invalid_publish_at")
+	ErrPublicationNotFound      = newError(KindNotFound, "publication_not_found")
+	ErrPublicationNotPending    = newError(KindConflict, "publication_not_pending")
+
+	ErrOrganizationNotFound     = newError(KindNotFound, "organization_not_found")
+	ErrEmployeeNotFound         = newError(KindNotFound, "employee_not_found")
+	ErrEmployeeAlreadyExists    = newError(KindConflict, "employee_already_exists")
+	ErrResponsibleAlreadyExists = newError(KindConflict, "responsible_already_exists")
+	ErrResponsibleNotFound      = newError(KindNotFound, "responsible_not_found")
+	ErrLastOwner                = newError(KindConflict, "last_owner")
+
+	ErrInvalidStatusTransition = newError(KindInvalid, "invalid_status_transition")
+	ErrVersionConflict         = newError(KindConflict, "version_conflict")
+	ErrWeakETag                = newError(KindPreconditionFailed, "weak_etag")
+
+	ErrUnauthorized       = newError(KindUnauthorized, "unauthorized")
+	ErrInvalidToken       = newError(KindUnauthorized, "invalid_token")
+	ErrTokenExpired       = newError(KindUnauthorized, "token_expired")
+	ErrInvalidCredentials = newError(KindUnauthorized, "invalid_credentials")
 )
@@ -0,0 +1,88 @@
+// Package i18n — каталог сообщений, которые сервис показывает пользователю. Переводы лежат в locales/<язык>.json:
+// ключ — стабильный код сообщения (для ошибок это code из ответа), значение — шаблон для fmt.Sprintf.
+// Язык выбирается по заголовку Accept-Language, по умолчанию русский.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+type Lang string
+
+const (
+	Ru Lang = "ru"
+	En Lang = "en"
+
+	Default = Ru
+)
+
+// langs и теги matcher перечислены в одном порядке: индекс совпадения из matcher указывает на язык в langs.
+var (
+	langs   = []Lang{Ru, En}
+	matcher = language.NewMatcher([]language.Tag{language.Russian, language.English})
+)
+
+//go:embed locales/*.json
+var locales embed.FS
+
+var catalog = mustLoad(langs)
+
+func mustLoad(langs []Lang) map[Lang]map[string]string {
+	path := "internal.i18n.mustLoad"
+
+	bundles := make(map[Lang]map[string]string, len(langs))
+	for _, lang := range langs {
+		data, err := locales.ReadFile("locales/" + string(lang) + ".json")
+		if err != nil {
+			panic(fmt.Errorf(path+".ReadFile, error: {%s}", err.Error()))
+		}
+		var bundle map[string]string
+		err = json.Unmarshal(data, &bundle)
+		if err != nil {
+			panic(fmt.Errorf(path+".Unmarshal %s, error: {%s}", lang, err.Error()))
+		}
+		bundles[lang] = bundle
+	}
+	return bundles
+}
+
+// Match выбирает язык ответа по значению заголовка Accept-Language.
+func Match(acceptLanguage string) Lang {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return Default
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return Default
+	}
+	return langs[index]
+}
+
+// Lookup возвращает сообщение по ключу. Если перевода на lang нет, берется язык по умолчанию.
+func Lookup(lang Lang, key string, args ...any) (string, bool) {
+	message, ok := catalog[lang][key]
+	if !ok {
+		message, ok = catalog[Default][key]
+	}
+	if !ok {
+		return "", false
+	}
+	if len(args) == 0 {
+		return message, true
+	}
+	return fmt.Sprintf(message, args...), true
+}
+
+// T возвращает сообщение по ключу или сам ключ, если его нет в каталоге.
+func T(lang Lang, key string, args ...any) string {
+	message, ok := Lookup(lang, key, args...)
+	if !ok {
+		return key
+	}
+	return message
+}
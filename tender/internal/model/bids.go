@@ -8,6 +8,17 @@ import (
 
 type BidsStatus string
 
+const (
+	BidsStatusCreated   = "Created"
+	BidsStatusPublished = "Published"
+	BidsStatusCanceled  = "Canceled"
+)
+
+const (
+	BidAuthorTypeOrganization = "Organization"
+	BidAuthorTypeUser         = "User"
+)
+
 type Bids struct {
 	ID              uuid.UUID `json:"id"`
 	TenderID        uuid.UUID `json:"tenderId"`
@@ -15,7 +26,7 @@ type Bids struct {
 	Title           string    `json:"name"`
 	Description     string    `json:"description"`
 	Status          string    `json:"status"`
-	Version         int       `son:"version"`
+	Version         int       `json:"version"`
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 	CreatorUsername string    `json:"creatorUsername"`
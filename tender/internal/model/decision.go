@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	BidDecisionApproved = "Approved"
+	BidDecisionRejected = "Rejected"
+	// BidDecisionLost проставляется конкурирующим предложениям, когда тендер достается другому
+	BidDecisionLost = "Lost"
+)
+
+type BidDecision struct {
+	ID        uuid.UUID `json:"id"`
+	BidID     uuid.UUID `json:"bidId"`
+	Username  string    `json:"username"`
+	Decision  string    `json:"decision"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BidFeedback struct {
+	ID             uuid.UUID `json:"id"`
+	BidID          uuid.UUID `json:"bidId"`
+	Description    string    `json:"description"`
+	AuthorUsername string    `json:"authorUsername"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
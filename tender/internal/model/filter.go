@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SortField — поле сортировки в терминах API: sort=-createdAt,name дает [{createdAt true} {name false}].
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// TenderFilter — условия списка тендеров. Нулевое значение поля означает, что по нему не фильтруют.
+// CreatedFrom включается в интервал, CreatedTo — нет. Sort применяется раньше порядка по умолчанию
+// (от новых к старым).
+type TenderFilter struct {
+	ServiceTypes    []string
+	Statuses        []string
+	OrganizationID  uuid.UUID
+	CreatedFrom     time.Time
+	CreatedTo       time.Time
+	Version         int
+	CreatorUsername string
+	Sort            []SortField
+}
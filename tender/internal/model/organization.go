@@ -14,6 +14,14 @@ const (
 	OrganizationTypeJSC OrganizationType = "JSC"
 )
 
+func (t OrganizationType) IsValid() bool {
+	switch t {
+	case OrganizationTypeIE, OrganizationTypeLLC, OrganizationTypeJSC:
+		return true
+	}
+	return false
+}
+
 type Organization struct {
 	ID          uuid.UUID                 `json:"id"`
 	Name        string                    `json:"name"`
@@ -25,9 +33,10 @@ type Organization struct {
 }
 
 type OrganizationResponsible struct {
-	ID             uuid.UUID    `json:"id"`
-	OrganizationID uuid.UUID    `json:"organization_id"`
-	UserID         uuid.UUID    `json:"user_id"`
-	Organization   Organization `json:"organization"`
-	Employee       Employee     `json:"user"`
+	ID             uuid.UUID        `json:"id"`
+	OrganizationID uuid.UUID        `json:"organization_id"`
+	UserID         uuid.UUID        `json:"user_id"`
+	Role           OrganizationRole `json:"role"`
+	Organization   Organization     `json:"organization"`
+	Employee       Employee         `json:"user"`
 }
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor — позиция записи в списке, отсортированном по (created_at, id) от новых к старым.
+// Пара уникальна, поэтому новые записи не сдвигают страницы, как это бывает с OFFSET.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// Less сравнивает позиции по (created_at, id): в списке от новых к старым после курсора идут меньшие.
+func (c Cursor) Less(other Cursor) bool {
+	if !c.CreatedAt.Equal(other.CreatedAt) {
+		return c.CreatedAt.Before(other.CreatedAt)
+	}
+	return c.ID.String() < other.ID.String()
+}
+
+// Page — запрошенная страница списка. After — последняя запись предыдущей страницы; без него
+// используется Offset, который оставлен для старых клиентов. WithTotal просит посчитать все записи.
+type Page struct {
+	Limit     int
+	Offset    int
+	After     *Cursor
+	WithTotal bool
+}
+
+// List — страница списка. Next указывает на последнюю запись страницы, если за ней могут быть еще;
+// Total заполняется, только если его запросили.
+type List[T any] struct {
+	Items []T
+	Next  *Cursor
+	Total *int
+}
+
+// NewList собирает страницу: полная страница означает, что за ней могут быть еще записи.
+func NewList[T interface{ Cursor() Cursor }](items []T, page Page) List[T] {
+	if items == nil {
+		items = []T{}
+	}
+	list := List[T]{Items: items}
+	if page.Limit > 0 && len(items) == page.Limit {
+		next := items[len(items)-1].Cursor()
+		list.Next = &next
+	}
+	return list
+}
+
+func (t Tender) Cursor() Cursor {
+	return Cursor{CreatedAt: t.CreatedAt, ID: t.ID}
+}
+
+func (b Bids) Cursor() Cursor {
+	return Cursor{CreatedAt: b.CreatedAt, ID: b.ID}
+}
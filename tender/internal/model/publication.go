@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PublicationPending  = "Pending"
+	PublicationDone     = "Done"
+	PublicationFailed   = "Failed"
+	PublicationCanceled = "Canceled"
+)
+
+// TenderPublication — отложенная публикация тендера. Тендер публикуется в PublishAt от имени CreatedBy;
+// Error хранит код ошибки, если публикация не удалась.
+type TenderPublication struct {
+	TenderID  uuid.UUID `json:"tenderId"`
+	PublishAt time.Time `json:"publishAt"`
+	Status    string    `json:"status"`
+	CreatedBy string    `json:"createdBy"`
+	Error     string    `json:"error"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationRole — роль сотрудника в организации. У сотрудника в организации ровно одна роль.
+type OrganizationRole string
+
+const (
+	OrganizationRoleOwner         OrganizationRole = "owner"
+	OrganizationRoleTenderManager OrganizationRole = "tender_manager"
+	OrganizationRoleBidAuthor     OrganizationRole = "bid_author"
+	OrganizationRoleReviewer      OrganizationRole = "reviewer"
+	OrganizationRoleViewer        OrganizationRole = "viewer"
+)
+
+type Permission string
+
+const (
+	// PermissionOrganizationManage — изменение и удаление организации, управление ее участниками
+	PermissionOrganizationManage Permission = "organization:manage"
+	// PermissionTenderView — просмотр неопубликованных тендеров организации
+	PermissionTenderView Permission = "tender:view"
+	// PermissionTenderManage — создание, редактирование, смена статуса и откат тендеров организации
+	PermissionTenderManage Permission = "tender:manage"
+	// PermissionBidView — просмотр предложений организации и их истории
+	PermissionBidView Permission = "bid:view"
+	// PermissionBidManage — подача, редактирование, смена статуса и откат предложений организации
+	PermissionBidManage Permission = "bid:manage"
+	// PermissionBidReview — решения, отзывы и голоса по предложениям на тендеры организации
+	PermissionBidReview Permission = "bid:review"
+)
+
+var rolePermissions = map[OrganizationRole][]Permission{
+	OrganizationRoleOwner: {
+		PermissionOrganizationManage,
+		PermissionTenderView,
+		PermissionTenderManage,
+		PermissionBidView,
+		PermissionBidManage,
+		PermissionBidReview,
+	},
+	OrganizationRoleTenderManager: {
+		PermissionTenderView,
+		PermissionTenderManage,
+		PermissionBidView,
+		PermissionBidReview,
+	},
+	OrganizationRoleBidAuthor: {
+		PermissionTenderView,
+		PermissionBidView,
+		PermissionBidManage,
+	},
+	OrganizationRoleReviewer: {
+		PermissionTenderView,
+		PermissionBidView,
+		PermissionBidReview,
+	},
+	OrganizationRoleViewer: {
+		PermissionTenderView,
+		PermissionBidView,
+	},
+}
+
+func (r OrganizationRole) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+func (r OrganizationRole) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+func (r OrganizationRole) Allows(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// RolesWithPermission возвращает роли, которые дают permission, — для проверок на стороне базы.
+func RolesWithPermission(permission Permission) []string {
+	var roles []string
+	for role := range rolePermissions {
+		if role.Allows(permission) {
+			roles = append(roles, string(role))
+		}
+	}
+	// Порядок фиксирован, чтобы текст запросов не менялся от вызова к вызову
+	sort.Strings(roles)
+	return roles
+}
+
+// OrganizationPermissions — действующие права сотрудника в одной организации.
+type OrganizationPermissions struct {
+	OrganizationID uuid.UUID        `json:"organizationId"`
+	Role           OrganizationRole `json:"role"`
+	Permissions    []Permission     `json:"permissions"`
+}
@@ -0,0 +1,24 @@
+package model
+
+// Границы найденных слов в сниппете. Символы из области для частного использования не встречаются
+// в обычном тексте, поэтому контроллер может экранировать сниппет целиком и только потом расставить разметку.
+const (
+	HighlightStart = "\ue000"
+	HighlightStop  = "\ue001"
+)
+
+// TenderSearch — поисковый запрос по тендерам. Query — текст в синтаксисе websearch: слова, "фразы"
+// в кавычках, or и -исключение. ServiceTypes сужает выдачу так же, как фильтр списка тендеров.
+type TenderSearch struct {
+	Query        string
+	ServiceTypes []string
+	Limit        int
+	Offset       int
+}
+
+// TenderSearchResult — найденный тендер с релевантностью и фрагментом текста, где подсвечены совпадения.
+type TenderSearchResult struct {
+	Tender  Tender
+	Rank    float64
+	Snippet string
+}
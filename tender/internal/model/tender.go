@@ -6,6 +6,17 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	TenderStatusCreated   = "Created"
+	TenderStatusPublished = "Published"
+	TenderStatusClosed    = "Closed"
+
+	// ClosedBySystem записывается в ClosedBy, когда тендер закрыт планировщиком по истечении срока
+	ClosedBySystem = "system"
+	// ClosedByAward записывается в ClosedBy, когда тендер закрыт согласованием предложения
+	ClosedByAward = "award"
+)
+
 type Tender struct {
 	ID              uuid.UUID `json:"id"`
 	OrganizationID  uuid.UUID `json:"organizationId"`
@@ -13,8 +24,19 @@ type Tender struct {
 	Description     string    `json:"description"`
 	ServiceType     string    `json:"serviceType"`
 	Status          string    `json:"status"`
-	Version         int       `son:"version"`
+	Version         int       `json:"version"`
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 	CreatorUsername string    `json:"creatorUsername"`
+	// SubmissionDeadline — срок подачи предложений; nil, если срока нет
+	SubmissionDeadline *time.Time `json:"submissionDeadline"`
+	ClosedBy           string     `json:"closedBy"`
+	// PublishAt — время отложенной публикации. Хранится в tender_publication, а в тендере заполняется
+	// только при создании
+	PublishAt *time.Time `json:"publishAt"`
+}
+
+// DeadlinePassed сообщает, что срок подачи предложений к моменту now истек.
+func (t Tender) DeadlinePassed(now time.Time) bool {
+	return t.SubmissionDeadline != nil && !now.Before(*t.SubmissionDeadline)
 }
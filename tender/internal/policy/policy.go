@@ -0,0 +1,95 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// Policy — единая точка проверки прав. Сервисы не смотрят на роли сами,
+// а спрашивают у Policy, разрешено ли пользователю действие в организации.
+type Policy struct {
+	organizationRepository repository.IOrganization
+	employeeRepository     repository.IEmployee
+}
+
+func New(organizationRepository repository.IOrganization, employeeRepository repository.IEmployee) *Policy {
+	return &Policy{
+		organizationRepository: organizationRepository,
+		employeeRepository:     employeeRepository,
+	}
+}
+
+// Authenticate проверяет, что пользователь существует. Для действий, не привязанных к организации.
+func (p *Policy) Authenticate(ctx context.Context, username string) error {
+	path := "internal.policy.Authenticate"
+	if username == "" {
+		return custom_errors.ErrUserNotFound
+	}
+	_, err := p.employeeRepository.GetEmployee(ctx, username)
+	if err != nil {
+		return fmt.Errorf(path+".GetEmployee, error: {%w}", err)
+	}
+	return nil
+}
+
+// Authorize возвращает ErrAccessDenied, если роль пользователя в организации не дает права permission.
+func (p *Policy) Authorize(
+	ctx context.Context,
+	username string,
+	organizationId uuid.UUID,
+	permission model.Permission,
+) error {
+	allowed, err := p.Can(ctx, username, organizationId, permission)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return custom_errors.ErrAccessDenied
+	}
+	return nil
+}
+
+// Can отвечает, есть ли у пользователя право permission в организации, не считая отказ ошибкой.
+func (p *Policy) Can(
+	ctx context.Context,
+	username string,
+	organizationId uuid.UUID,
+	permission model.Permission,
+) (bool, error) {
+	path := "internal.policy.Can"
+	if username == "" {
+		return false, custom_errors.ErrUserNotFound
+	}
+	role, err := p.organizationRepository.GetMemberRole(ctx, organizationId, username)
+	if err != nil {
+		return false, fmt.Errorf(path+".GetMemberRole, error: {%w}", err)
+	}
+	return role.Allows(permission), nil
+}
+
+// Permissions возвращает действующие права пользователя во всех организациях, где у него есть роль.
+func (p *Policy) Permissions(ctx context.Context, username string) ([]model.OrganizationPermissions, error) {
+	path := "internal.policy.Permissions"
+	err := p.Authenticate(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := p.organizationRepository.GetEmployeeRoles(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf(path+".GetEmployeeRoles, error: {%w}", err)
+	}
+	res := make([]model.OrganizationPermissions, 0, len(roles))
+	for _, r := range roles {
+		res = append(res, model.OrganizationPermissions{
+			OrganizationID: r.OrganizationID,
+			Role:           r.Role,
+			Permissions:    r.Role.Permissions(),
+		})
+	}
+	return res, nil
+}
@@ -32,20 +32,30 @@ func (bR *BidsRepository) CreateBids(ctx context.Context, bids *model.Bids) (mod
                   creator_username)
 					VALUES ($1, $2, $3, $4, $5, $6)
 					RETURNING id,
+                  tender_id,
+                  organization_id,
                   title,
                   description,
                   status,
                   version,
                   creator_username,
 									created_at`
+	tx, err := bR.DB.Begin(ctx)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
 	var res model.Bids
-	err := bR.DB.Pool.QueryRow(ctx, sql,
+	err = tx.QueryRow(ctx, sql,
 		bids.TenderID,
 		bids.OrganizationID,
 		bids.Title,
 		bids.Description,
 		bids.Status,
 		bids.CreatorUsername).Scan(&res.ID,
+		&res.TenderID,
+		&res.OrganizationID,
 		&res.Title,
 		&res.Description,
 		&res.Status,
@@ -61,31 +71,16 @@ func (bR *BidsRepository) CreateBids(ctx context.Context, bids *model.Bids) (mod
 		}
 		return model.Bids{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
 	}
-	return res, nil
-}
 
-func (bR *BidsRepository) IsUserAuthorizedToCreateBid(
-	ctx context.Context,
-	bids *model.Bids,
-) (bool, error) {
-	path := "internal.repository.bids.IsUserAuthorizedToCreateBid"
-	query := `
-		SELECT COUNT(*)
-		FROM organization_responsible 
-		WHERE user_id = (SELECT id FROM employee WHERE username = $1)
-		AND organization_id = $2
-		UNION
-		SELECT COUNT(*)
-		FROM employee 
-		WHERE username = $1
-	`
-
-	var count int
-	err := bR.DB.Pool.QueryRow(ctx, query, bids.CreatorUsername, bids.OrganizationID).Scan(&count)
+	err = saveBidVersion(ctx, tx, res.ID)
 	if err != nil {
-		return false, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+		return model.Bids{}, fmt.Errorf(path+".saveBidVersion, error: {%s}", err.Error())
 	}
-	return count > 0, nil
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return res, nil
 }
 
 func (bR *BidsRepository) IsTenderValid(ctx context.Context, tenderID uuid.UUID) (bool, error) {
@@ -94,11 +89,11 @@ func (bR *BidsRepository) IsTenderValid(ctx context.Context, tenderID uuid.UUID)
 		SELECT COUNT(*)
 		FROM tender
 		WHERE id = $1
-		AND status <> 'Canceled';
+		AND status = $2;
 	`
 
 	var count int
-	err := bR.DB.Pool.QueryRow(ctx, query, tenderID).Scan(&count)
+	err := bR.DB.Querier(ctx).QueryRow(ctx, query, tenderID, model.TenderStatusPublished).Scan(&count)
 	if err != nil {
 		return false, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
 	}
@@ -106,74 +101,92 @@ func (bR *BidsRepository) IsTenderValid(ctx context.Context, tenderID uuid.UUID)
 	return count > 0, nil
 }
 
-func (bR *BidsRepository) GetBids(ctx context.Context, user string, limit, offset int) ([]model.Bids, error) {
+func (bR *BidsRepository) GetBids(ctx context.Context, user string, page model.Page) (model.List[model.Bids], error) {
 	path := "internal.repository.bids.GetBids"
-	sql := `SELECT id, tender_id, organization_id, title, description, status, version, creator_username
-					FROM bids WHERE creator_username = $1
-					ORDER BY created_at DESC LIMIT $2 OFFSET $3`
 
-	var res []model.Bids
-	rows, err := bR.DB.Pool.Query(ctx, sql, user, limit, offset)
+	from := `FROM bids b WHERE b.creator_username = $1`
+	args := []interface{}{user}
+	pageSQL, pageArgs := keysetPage("b", page, args)
+	sql := `SELECT b.id, b.tender_id, b.organization_id, b.title, b.description, b.status, b.version,
+	               b.creator_username, b.created_at
+					` + from + pageSQL
+
+	q := bR.DB.Querier(ctx)
+	rows, err := q.Query(ctx, sql, pageArgs...)
 	if err != nil {
-		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+		return model.List[model.Bids]{}, fmt.Errorf(path+".Query, error: {%s}", err.Error())
 	}
+	defer rows.Close()
 
-	for rows.Next() {
-		var bids model.Bids
-		err = rows.Scan(&bids.ID,
-			&bids.TenderID,
-			&bids.OrganizationID,
-			&bids.Title,
-			&bids.Description,
-			&bids.Status,
-			&bids.Version,
-			&bids.CreatorUsername)
-		if err != nil {
-			var pgErr *pgconn.PgError
-			if ok := errors.As(err, &pgErr); ok {
-				return []model.Bids{}, err
-			}
-			if errors.Is(err, pgx.ErrNoRows) {
-				return []model.Bids{}, custom_errors.ErrBidsNotFound
-			}
-			return []model.Bids{}, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
-		}
-		res = append(res, bids)
-	}
-	if len(res) == 0 {
-		return nil, custom_errors.ErrBidsNotFound
+	res, err := scanBids(rows)
+	if err != nil {
+		return model.List[model.Bids]{}, fmt.Errorf(path+".scanBids, error: {%s}", err.Error())
 	}
-	return res, nil
+	return withTotal(ctx, q, model.NewList(res, page), page, from, args)
 }
 
 func (bR *BidsRepository) GetBidsByTenderId(
 	ctx context.Context,
 	user string,
 	tenderId uuid.UUID,
-	limit, offset int,
-) ([]model.Bids, error) {
+	page model.Page,
+) (model.List[model.Bids], error) {
 	path := "internal.repository.bids.GetBidsByTenderId"
-	sql := `SELECT id,
-                  title,
-                  description,
-                  status,
-                  version,
-                  creator_username,
-									created_at
-					FROM bids
-					WHERE tender_id = $1 AND creator_username = $2
-					ORDER BY created_at DESC
-					LIMIT $3 OFFSET $4`
 
-	var res []model.Bids
-	rows, err := bR.DB.Pool.Query(ctx, sql, tenderId, user, limit, offset)
+	q := bR.DB.Querier(ctx)
+	tenderSQL := `SELECT ` + tenderVisibleTo("t", 2) + ` FROM tender t WHERE t.id = $1`
+	var tenderVisible bool
+	err := q.QueryRow(ctx, tenderSQL, tenderId, user).Scan(&tenderVisible)
 	if err != nil {
-		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.List[model.Bids]{}, custom_errors.ErrTenderNotFound
+		}
+		return model.List[model.Bids]{}, fmt.Errorf(path+".CheckTender, error: {%s}", err.Error())
+	}
+	if !tenderVisible {
+		return model.List[model.Bids]{}, custom_errors.ErrAccessDenied
+	}
+
+	from := `FROM bids b
+					JOIN tender t ON t.id = b.tender_id
+					WHERE b.tender_id = $1 AND ` + bidVisibleTo("b", "t", 2)
+	args := []interface{}{tenderId, user}
+	pageSQL, pageArgs := keysetPage("b", page, args)
+	sql := `SELECT b.id,
+                  b.tender_id,
+                  b.organization_id,
+                  b.title,
+                  b.description,
+                  b.status,
+                  b.version,
+                  b.creator_username,
+                  b.created_at
+					` + from + pageSQL
+
+	rows, err := q.Query(ctx, sql, pageArgs...)
+	if err != nil {
+		return model.List[model.Bids]{}, fmt.Errorf(path+".Query, error: {%s}", err.Error())
 	}
+	defer rows.Close()
+
+	res, err := scanBids(rows)
+	if err != nil {
+		return model.List[model.Bids]{}, fmt.Errorf(path+".scanBids, error: {%s}", err.Error())
+	}
+	return withTotal(ctx, q, model.NewList(res, page), page, from, args)
+}
 
+// scanBids читает строки списка предложений и закрывает rows, чтобы соединение освободилось
+// до следующего запроса.
+func scanBids(rows pgx.Rows) ([]model.Bids, error) {
+	defer rows.Close()
+
+	res := make([]model.Bids, 0)
 	for rows.Next() {
 		var bids model.Bids
-		err = rows.Scan(&bids.ID,
+		err := rows.Scan(&bids.ID,
+			&bids.TenderID,
+			&bids.OrganizationID,
 			&bids.Title,
 			&bids.Description,
 			&bids.Status,
@@ -181,75 +194,29 @@ func (bR *BidsRepository) GetBidsByTenderId(
 			&bids.CreatorUsername,
 			&bids.CreatedAt)
 		if err != nil {
-			var pgErr *pgconn.PgError
-			if ok := errors.As(err, &pgErr); ok {
-				return []model.Bids{}, err
-			}
-			if errors.Is(err, pgx.ErrNoRows) {
-				return []model.Bids{}, custom_errors.ErrBidsNotFound
-			}
-			return []model.Bids{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+			return nil, err
 		}
 		res = append(res, bids)
 	}
-	if len(res) == 0 {
-		return []model.Bids{}, custom_errors.ErrBidsNotFound
-	}
-	return res, nil
-}
-
-func (bR *BidsRepository) CheckUserExists(ctx context.Context, username string) (bool, error) {
-	path := "internal.repository.user.CheckUserExists"
-	sql := `SELECT 1 FROM employee WHERE username = $1 LIMIT 1`
-
-	var exists int
-	err := bR.DB.Pool.QueryRow(ctx, sql, username).Scan(&exists)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return false, nil
-		}
-		return false, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
-	}
-	return exists == 1, nil
+	return res, rows.Err()
 }
 
 func (bR *BidsRepository) UpdateBids(ctx context.Context, bids *model.Bids) (model.Bids, error) {
 	path := "internal.repository.bids.UpdateBids"
 
-	checkUserSQL := `SELECT 1 FROM bids WHERE id = $1 AND creator_username = $2`
-
-	var userExists int
-
-	err := bR.DB.Pool.QueryRow(ctx, checkUserSQL, bids.ID, bids.CreatorUsername).Scan(&userExists)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return model.Bids{}, custom_errors.ErrBidsNotFound
-		}
-		return model.Bids{}, fmt.Errorf(path+".CheckUser, error: {%s}", err.Error())
-	}
-	if userExists == 0 {
-		return model.Bids{}, custom_errors.ErrUserNotFound
-	}
-	query := "UPDATE bids SET updated_at = NOW(),version = version + 1, "
-	params := []interface{}{}
-	paramIndex := 1
-
+	update := &queryBuilder{sets: []string{"updated_at = NOW()", "version = version + 1"}}
 	if bids.Title != "" {
-		query += fmt.Sprintf("title = $%d, ", paramIndex)
-		params = append(params, bids.Title)
-		paramIndex++
+		update.set("title", bids.Title)
 	}
 	if bids.Description != "" {
-		query += fmt.Sprintf("description = $%d, ", paramIndex)
-		params = append(params, bids.Description)
-		paramIndex++
+		update.set("description", bids.Description)
 	}
+	update.where("id = ?", bids.ID)
 
-	query = query[:len(query)-2]
-	query += fmt.Sprintf(" WHERE id = $%d ", paramIndex)
-	params = append(params, bids.ID)
-
-	query += `RETURNING id,
+	query := `UPDATE bids SET ` + update.setSQL() + update.whereSQL() + `
+                RETURNING id,
+                  tender_id,
+                  organization_id,
                   title,
                   description,
                   status,
@@ -257,9 +224,22 @@ func (bR *BidsRepository) UpdateBids(ctx context.Context, bids *model.Bids) (mod
                   creator_username,
 									created_at`
 
+	tx, err := bR.DB.Begin(ctx)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = lockBidVersion(ctx, tx, bids.ID, bids.Version)
+	if err != nil {
+		return model.Bids{}, err
+	}
+
 	var res model.Bids
-	err = bR.DB.Pool.QueryRow(ctx, query, params...).
+	err = tx.QueryRow(ctx, query, update.args...).
 		Scan(&res.ID,
+			&res.TenderID,
+			&res.OrganizationID,
 			&res.Title,
 			&res.Description,
 			&res.Status,
@@ -276,27 +256,41 @@ func (bR *BidsRepository) UpdateBids(ctx context.Context, bids *model.Bids) (mod
 		}
 		return model.Bids{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
 	}
+
+	err = saveBidVersion(ctx, tx, res.ID)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".saveBidVersion, error: {%s}", err.Error())
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
 	return res, nil
 }
 
 func (bR *BidsRepository) GetBidStatus(ctx context.Context, bidId uuid.UUID, user string) (string, error) {
 	path := "internal.repository.bids.GetStatus"
 
-	sql := `SELECT status FROM bids WHERE id = $1 AND creator_username = $2`
+	sql := `SELECT b.status, ` + bidVisibleTo("b", "t", 2) + `
+	        FROM bids b
+	        JOIN tender t ON t.id = b.tender_id
+	        WHERE b.id = $1`
 
-	var status string
-	err := bR.DB.Pool.QueryRow(ctx, sql, bidId, user).Scan(&status)
+	var (
+		status  string
+		visible bool
+	)
+	err := bR.DB.Querier(ctx).QueryRow(ctx, sql, bidId, user).Scan(&status, &visible)
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if ok := errors.As(err, &pgErr); ok {
-			return "", err
-		}
 		if errors.Is(err, pgx.ErrNoRows) {
 			// Возвращаем ошибку, если предложение не найдено
 			return "", custom_errors.ErrBidsNotFound
 		}
 		return "", fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
 	}
+	if !visible {
+		return "", custom_errors.ErrAccessDenied
+	}
 
 	return status, nil
 }
@@ -304,9 +298,11 @@ func (bR *BidsRepository) GetBidStatus(ctx context.Context, bidId uuid.UUID, use
 func (bR *BidsRepository) UpdateBidsStatus(ctx context.Context, bids model.Bids) (model.Bids, error) {
 	path := "internal.repository.bids.UpdateBidsStatus"
 
-	sql := `UPDATE bids SET status = $1, updated_at = NOW(), version = version + 1 
-			WHERE id = $2 AND creator_username = $3 
+	sql := `UPDATE bids SET status = $1, updated_at = NOW(), version = version + 1
+			WHERE id = $2
 			RETURNING id,
+                  tender_id,
+                  organization_id,
                   title,
                   description,
                   status,
@@ -314,9 +310,22 @@ func (bR *BidsRepository) UpdateBidsStatus(ctx context.Context, bids model.Bids)
                   creator_username,
 									created_at`
 
+	tx, err := bR.DB.Begin(ctx)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = lockBidVersion(ctx, tx, bids.ID, bids.Version)
+	if err != nil {
+		return model.Bids{}, err
+	}
+
 	var res model.Bids
-	err := bR.DB.Pool.QueryRow(ctx, sql, bids.Status, bids.ID, bids.CreatorUsername).
+	err = tx.QueryRow(ctx, sql, bids.Status, bids.ID).
 		Scan(&res.ID,
+			&res.TenderID,
+			&res.OrganizationID,
 			&res.Title,
 			&res.Description,
 			&res.Status,
@@ -334,6 +343,14 @@ func (bR *BidsRepository) UpdateBidsStatus(ctx context.Context, bids model.Bids)
 		return model.Bids{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
 	}
 
+	err = saveBidVersion(ctx, tx, res.ID)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".saveBidVersion, error: {%s}", err.Error())
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
 	return res, nil
 }
 
@@ -344,46 +361,436 @@ func (bR *BidsRepository) UpdateBidsDecision(
 ) (model.Bids, error) {
 	path := "internal.repository.bids.UpdateBidsDecision"
 
-	checkBidSQL := `SELECT creator_username FROM bids WHERE id = $1`
-	var creatorUsername string
-	err := bR.DB.Pool.QueryRow(ctx, checkBidSQL, bidId).Scan(&creatorUsername)
+	tx, err := bR.DB.Begin(ctx)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Блокируем предложение, чтобы параллельные голоса подсчитывались последовательно
+	// Блокируем и тендер, чтобы два предложения не могли выиграть его одновременно
+	checkBidSQL := `SELECT b.status, b.decision, t.id, t.organization_id, t.status
+	                FROM bids b
+	                JOIN tender t ON t.id = b.tender_id
+	                WHERE b.id = $1
+	                FOR UPDATE OF b, t`
+	var (
+		bidStatus       string
+		currentDecision *string
+		tenderID        uuid.UUID
+		organizationID  uuid.UUID
+		tenderStatus    string
+	)
+	err = tx.QueryRow(ctx, checkBidSQL, bidId).
+		Scan(&bidStatus, &currentDecision, &tenderID, &organizationID, &tenderStatus)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return model.Bids{}, custom_errors.ErrBidsNotFound
 		}
 		return model.Bids{}, fmt.Errorf(path+".CheckBid, error: {%s}", err.Error())
 	}
+	if currentDecision != nil {
+		return model.Bids{}, custom_errors.ErrDecisionAlreadyMade
+	}
+	// Черновик и отозванное предложение рецензенты не рассматривают
+	if bidStatus != model.BidsStatusPublished {
+		return model.Bids{}, custom_errors.ErrBidNotPublished
+	}
+	if tenderStatus == model.TenderStatusClosed {
+		return model.Bids{}, custom_errors.ErrTenderClosed
+	}
+
+	// Право голоса проверяет сервис, здесь считаем только размер кворума
+	reviewersSQL := `SELECT COUNT(*)
+	                 FROM organization_responsible
+	                 WHERE organization_id = $1 AND role = ANY($2)`
+	var reviewers int
+	err = tx.QueryRow(ctx, reviewersSQL, organizationID, model.RolesWithPermission(model.PermissionBidReview)).
+		Scan(&reviewers)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".CountReviewers, error: {%s}", err.Error())
+	}
 
-	if creatorUsername != username {
-		return model.Bids{}, custom_errors.ErrUserNotFound
+	voteSQL := `INSERT INTO bid_decision (bid_id, username, decision)
+	            VALUES ($1, $2, $3)
+	            ON CONFLICT (bid_id, username) DO NOTHING`
+	tag, err := tx.Exec(ctx, voteSQL, bidId, username, decision)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Vote, error: {%s}", err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return model.Bids{}, custom_errors.ErrDecisionAlreadyMade
 	}
 
-	query := `UPDATE bids
-	          SET decision = $1, version = version + 1
-	          WHERE id = $2
-	          RETURNING id,
+	tallySQL := `SELECT COUNT(*) FILTER (WHERE decision = $2), COUNT(*) FILTER (WHERE decision = $3)
+	             FROM bid_decision
+	             WHERE bid_id = $1`
+	var approved, rejected int
+	err = tx.QueryRow(ctx, tallySQL, bidId, model.BidDecisionApproved, model.BidDecisionRejected).
+		Scan(&approved, &rejected)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Tally, error: {%s}", err.Error())
+	}
+
+	// Одного отказа достаточно для отклонения, для согласования нужен кворум min(3, число рецензентов)
+	outcome := ""
+	switch {
+	case rejected > 0:
+		outcome = model.BidDecisionRejected
+	case approved >= min(3, reviewers):
+		outcome = model.BidDecisionApproved
+	}
+	if outcome != "" {
+		updateSQL := `UPDATE bids SET decision = $1, updated_at = NOW(), version = version + 1 WHERE id = $2`
+		_, err = tx.Exec(ctx, updateSQL, outcome, bidId)
+		if err != nil {
+			return model.Bids{}, fmt.Errorf(path+".Update, error: {%s}", err.Error())
+		}
+		err = saveBidVersion(ctx, tx, bidId)
+		if err != nil {
+			return model.Bids{}, fmt.Errorf(path+".saveBidVersion, error: {%s}", err.Error())
+		}
+	}
+	if outcome == model.BidDecisionApproved {
+		err = bR.awardTender(ctx, tx, tenderID, bidId)
+		if errors.Is(err, custom_errors.ErrBidNotPublished) {
+			return model.Bids{}, err
+		}
+		if err != nil {
+			return model.Bids{}, fmt.Errorf(path+".awardTender, error: {%s}", err.Error())
+		}
+	}
+
+	query := `SELECT id,
+                  tender_id,
+                  organization_id,
                   title,
                   description,
                   status,
                   version,
                   creator_username,
-									created_at`
+                  created_at
+					FROM bids
+					WHERE id = $1`
 	var res model.Bids
-	err = bR.DB.Pool.QueryRow(ctx, query, decision, bidId).Scan(
+	err = tx.QueryRow(ctx, query, bidId).Scan(
 		&res.ID,
+		&res.TenderID, &res.OrganizationID,
 		&res.Title, &res.Description, &res.Status,
 		&res.Version, &res.CreatorUsername, &res.CreatedAt,
 	)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+// awardTender закрывает тендер в пользу согласованного предложения,
+// а остальные предложения без решения помечает проигравшими.
+// Вызывается под блокировкой строки предложения; статус перечитывается здесь же,
+// чтобы тендер не достался предложению, которое успели отозвать.
+func (bR *BidsRepository) awardTender(ctx context.Context, tx pgx.Tx, tenderId, bidId uuid.UUID) error {
+	path := "internal.repository.bids.awardTender"
+
+	statusSQL := `SELECT status FROM bids WHERE id = $1 FOR UPDATE`
+	var status string
+	err := tx.QueryRow(ctx, statusSQL, bidId).Scan(&status)
+	if err != nil {
+		return fmt.Errorf(path+".BidStatus, error: {%s}", err.Error())
+	}
+	if status != model.BidsStatusPublished {
+		return custom_errors.ErrBidNotPublished
+	}
+
+	closeSQL := `UPDATE tender
+	             SET status = $1, awarded_bid_id = $2, closed_by = $3, updated_at = NOW(), version = version + 1
+	             WHERE id = $4`
+	_, err = tx.Exec(ctx, closeSQL, model.TenderStatusClosed, bidId, model.ClosedByAward, tenderId)
+	if err != nil {
+		return fmt.Errorf(path+".CloseTender, error: {%s}", err.Error())
+	}
+	err = saveTenderVersion(ctx, tx, tenderId)
+	if err != nil {
+		return fmt.Errorf(path+".saveTenderVersion, error: {%s}", err.Error())
+	}
+
+	err = markBidsLost(ctx, tx, tenderId, bidId)
+	if err != nil {
+		return fmt.Errorf(path+".markBidsLost, error: {%s}", err.Error())
+	}
+	return nil
+}
+
+// markBidsLost помечает проигравшими все предложения тендера без решения, кроме exceptBidId.
+func markBidsLost(ctx context.Context, tx pgx.Tx, tenderId, exceptBidId uuid.UUID) error {
+	sql := `UPDATE bids
+	        SET decision = $1, updated_at = NOW(), version = version + 1
+	        WHERE tender_id = $2 AND id <> $3 AND decision IS NULL
+	        RETURNING id`
+	rows, err := tx.Query(ctx, sql, model.BidDecisionLost, tenderId, exceptBidId)
+	if err != nil {
+		return err
+	}
+	lost, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return err
+	}
+	for _, id := range lost {
+		err = saveBidVersion(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bR *BidsRepository) GetBidDecisions(ctx context.Context, bidId uuid.UUID) ([]model.BidDecision, error) {
+	path := "internal.repository.bids.GetBidDecisions"
+	sql := `SELECT id, bid_id, username, decision, created_at
+					FROM bid_decision
+					WHERE bid_id = $1
+					ORDER BY created_at`
+
+	rows, err := bR.DB.Querier(ctx).Query(ctx, sql, bidId)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	defer rows.Close()
+
+	res := make([]model.BidDecision, 0)
+	for rows.Next() {
+		var decision model.BidDecision
+		err = rows.Scan(&decision.ID,
+			&decision.BidID,
+			&decision.Username,
+			&decision.Decision,
+			&decision.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+		}
+		res = append(res, decision)
+	}
+	return res, nil
+}
+
+func (bR *BidsRepository) GetBidVersions(
+	ctx context.Context,
+	bidId uuid.UUID,
+	limit, offset int,
+) ([]model.Bids, error) {
+	path := "internal.repository.bids.GetBidVersions"
+	sql := `SELECT bid_id,
+                  tender_id,
+                  organization_id,
+                  title,
+                  description,
+                  status,
+                  version,
+                  creator_username,
+                  created_at
+					FROM bids_version
+					WHERE bid_id = $1
+					ORDER BY version DESC
+					LIMIT $2 OFFSET $3`
+
+	rows, err := bR.DB.Querier(ctx).Query(ctx, sql, bidId, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	defer rows.Close()
+
+	res := make([]model.Bids, 0)
+	for rows.Next() {
+		var bids model.Bids
+		err = rows.Scan(&bids.ID,
+			&bids.TenderID,
+			&bids.OrganizationID,
+			&bids.Title,
+			&bids.Description,
+			&bids.Status,
+			&bids.Version,
+			&bids.CreatorUsername,
+			&bids.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+		}
+		res = append(res, bids)
+	}
+	return res, nil
+}
+
+func (bR *BidsRepository) GetBidVersion(ctx context.Context, bidId uuid.UUID, version int) (model.Bids, error) {
+	path := "internal.repository.bids.GetBidVersion"
+	sql := `SELECT bid_id,
+                  tender_id,
+                  organization_id,
+                  title,
+                  description,
+                  status,
+                  version,
+                  creator_username,
+                  created_at
+					FROM bids_version
+					WHERE bid_id = $1 AND version = $2`
+
+	var res model.Bids
+	err := bR.DB.Querier(ctx).QueryRow(ctx, sql, bidId, version).Scan(&res.ID,
+		&res.TenderID,
+		&res.OrganizationID,
+		&res.Title,
+		&res.Description,
+		&res.Status,
+		&res.Version,
+		&res.CreatorUsername,
+		&res.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return model.Bids{}, custom_errors.ErrBidsNotFound
+			return model.Bids{}, custom_errors.ErrVersionNotFound
 		}
-		var pgErr *pgconn.PgError
-		if ok := errors.As(err, &pgErr); ok {
-			return model.Bids{}, err
+		return model.Bids{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (bR *BidsRepository) RollbackBids(
+	ctx context.Context,
+	bidId uuid.UUID,
+	version, expectedVersion int,
+) (model.Bids, error) {
+	path := "internal.repository.bids.RollbackBids"
+
+	tx, err := bR.DB.Begin(ctx)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = lockBidVersion(ctx, tx, bidId, expectedVersion)
+	if err != nil {
+		return model.Bids{}, err
+	}
+
+	// Откат считается новой правкой: параметры берутся из снимка, версия увеличивается
+	sql := `UPDATE bids b
+	        SET title = v.title,
+	            description = v.description,
+	            updated_at = NOW(),
+	            version = b.version + 1
+	        FROM bids_version v
+	        WHERE b.id = $1 AND v.bid_id = b.id AND v.version = $2
+	        RETURNING b.id,
+                  b.tender_id,
+                  b.organization_id,
+                  b.title,
+                  b.description,
+                  b.status,
+                  b.version,
+                  b.creator_username,
+                  b.created_at`
+
+	var res model.Bids
+	err = tx.QueryRow(ctx, sql, bidId, version).Scan(&res.ID,
+		&res.TenderID,
+		&res.OrganizationID,
+		&res.Title,
+		&res.Description,
+		&res.Status,
+		&res.Version,
+		&res.CreatorUsername,
+		&res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Bids{}, custom_errors.ErrVersionNotFound
 		}
 		return model.Bids{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
 	}
 
+	err = saveBidVersion(ctx, tx, res.ID)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".saveBidVersion, error: {%s}", err.Error())
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+// lockBidVersion блокирует строку предложения до конца транзакции и сверяет ее версию с ожидаемой.
+// Нулевая ожидаемая версия означает, что версия не проверяется.
+func lockBidVersion(ctx context.Context, tx postgres.Querier, bidId uuid.UUID, expected int) error {
+	path := "internal.repository.bids.lockBidVersion"
+	sql := `SELECT version FROM bids WHERE id = $1 FOR UPDATE`
+
+	var current int
+	err := tx.QueryRow(ctx, sql, bidId).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return custom_errors.ErrBidsNotFound
+		}
+		return fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	if expected != 0 && current != expected {
+		return custom_errors.ErrVersionConflict
+	}
+	return nil
+}
+
+func saveBidVersion(ctx context.Context, tx pgx.Tx, bidId uuid.UUID) error {
+	sql := `INSERT INTO bids_version (bid_id,
+	                          version,
+	                          tender_id,
+	                          organization_id,
+	                          title,
+	                          description,
+	                          status,
+	                          creator_username)
+	        SELECT id, version, tender_id, organization_id, title, description, status, creator_username
+	        FROM bids
+	        WHERE id = $1`
+	_, err := tx.Exec(ctx, sql, bidId)
+	return err
+}
+
+// LockBid блокирует строку предложения до конца транзакции из контекста.
+func (bR *BidsRepository) LockBid(ctx context.Context, bidId uuid.UUID) error {
+	return lockBidVersion(ctx, bR.DB.Querier(ctx), bidId, 0)
+}
+
+func (bR *BidsRepository) GetBidById(ctx context.Context, bidId uuid.UUID) (model.Bids, error) {
+	path := "internal.repository.bids.GetBidById"
+	sql := `SELECT id,
+                  tender_id,
+                  organization_id,
+                  title,
+                  description,
+                  status,
+                  version,
+                  creator_username,
+                  created_at
+					FROM bids
+					WHERE id = $1`
+
+	var res model.Bids
+	err := bR.DB.Querier(ctx).QueryRow(ctx, sql, bidId).Scan(&res.ID,
+		&res.TenderID,
+		&res.OrganizationID,
+		&res.Title,
+		&res.Description,
+		&res.Status,
+		&res.Version,
+		&res.CreatorUsername,
+		&res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Bids{}, custom_errors.ErrBidsNotFound
+		}
+		return model.Bids{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
 	return res, nil
 }
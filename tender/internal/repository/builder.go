@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+)
+
+// queryBuilder собирает части запроса с нумерованными параметрами. Значения попадают в запрос только
+// параметрами, а имена колонок — только из белых списков вида columns, поэтому ввод пользователя
+// не оказывается в тексте SQL.
+type queryBuilder struct {
+	args  []interface{}
+	conds []string
+	sets  []string
+	order []string
+}
+
+// columns — белый список: имя поля в API -> выражение SQL.
+type columns map[string]string
+
+// arg добавляет значение в параметры и возвращает его номер.
+func (q *queryBuilder) arg(value interface{}) int {
+	q.args = append(q.args, value)
+	return len(q.args)
+}
+
+// where добавляет условие. Каждый знак ? в cond по порядку заменяется параметром из values.
+func (q *queryBuilder) where(cond string, values ...interface{}) {
+	for _, value := range values {
+		cond = strings.Replace(cond, "?", fmt.Sprintf("$%d", q.arg(value)), 1)
+	}
+	q.conds = append(q.conds, cond)
+}
+
+// set добавляет присваивание для UPDATE. column — имя колонки из кода, а не из запроса клиента.
+func (q *queryBuilder) set(column string, value interface{}) {
+	q.sets = append(q.sets, fmt.Sprintf("%s = $%d", column, q.arg(value)))
+}
+
+// orderBy добавляет сортировку по полям API. Поле, которого нет в белом списке, отклоняется.
+func (q *queryBuilder) orderBy(allowed columns, fields []model.SortField) error {
+	for _, f := range fields {
+		column, ok := allowed[f.Field]
+		if !ok {
+			return custom_errors.ErrUnprocessableEntity
+		}
+		if f.Desc {
+			column += " DESC"
+		}
+		q.order = append(q.order, column)
+	}
+	return nil
+}
+
+func (q *queryBuilder) whereSQL() string {
+	if len(q.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(q.conds, " AND ")
+}
+
+func (q *queryBuilder) setSQL() string {
+	return strings.Join(q.sets, ", ")
+}
+
+func (q *queryBuilder) orderSQL() string {
+	if len(q.order) == 0 {
+		return ""
+	}
+	return " ORDER BY " + strings.Join(q.order, ", ")
+}
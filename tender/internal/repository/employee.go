@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/pkg/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type EmployeeRepository struct {
+	*postgres.DB
+}
+
+func NewEmployeeRepository(db *postgres.DB) *EmployeeRepository {
+	return &EmployeeRepository{db}
+}
+
+func (eR *EmployeeRepository) CreateEmployee(ctx context.Context, employee model.Employee) (model.Employee, error) {
+	path := "internal.repository.employee.CreateEmployee"
+	sql := `INSERT INTO employee (username, first_name, last_name, password_hash)
+					VALUES ($1, $2, $3, NULLIF($4, ''))
+					RETURNING id, username, COALESCE(first_name, ''), COALESCE(last_name, ''), created_at, updated_at`
+
+	var res model.Employee
+	err := eR.DB.Querier(ctx).QueryRow(ctx, sql,
+		employee.Username,
+		employee.FirstName,
+		employee.LastName,
+		employee.PasswordHash).Scan(&res.ID,
+		&res.Username,
+		&res.FirstName,
+		&res.LastName,
+		&res.CreatedAt,
+		&res.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok {
+			if pgErr.Code == "23505" {
+				return model.Employee{}, custom_errors.ErrEmployeeAlreadyExists
+			}
+		}
+		return model.Employee{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (eR *EmployeeRepository) GetEmployees(ctx context.Context, limit, offset int) ([]model.Employee, error) {
+	path := "internal.repository.employee.GetEmployees"
+	sql := `SELECT id, username, COALESCE(first_name, ''), COALESCE(last_name, ''), created_at, updated_at
+					FROM employee
+					ORDER BY username
+					LIMIT $1 OFFSET $2`
+
+	rows, err := eR.DB.Querier(ctx).Query(ctx, sql, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	defer rows.Close()
+
+	res := make([]model.Employee, 0)
+	for rows.Next() {
+		var employee model.Employee
+		err = rows.Scan(&employee.ID,
+			&employee.Username,
+			&employee.FirstName,
+			&employee.LastName,
+			&employee.CreatedAt,
+			&employee.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+		}
+		res = append(res, employee)
+	}
+	return res, nil
+}
+
+func (eR *EmployeeRepository) GetEmployee(ctx context.Context, username string) (model.Employee, error) {
+	path := "internal.repository.employee.GetEmployee"
+	sql := `SELECT id, username, COALESCE(first_name, ''), COALESCE(last_name, ''), created_at, updated_at
+					FROM employee
+					WHERE username = $1`
+
+	var res model.Employee
+	err := eR.DB.Querier(ctx).QueryRow(ctx, sql, username).Scan(&res.ID,
+		&res.Username,
+		&res.FirstName,
+		&res.LastName,
+		&res.CreatedAt,
+		&res.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Employee{}, custom_errors.ErrUserNotFound
+		}
+		return model.Employee{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (eR *EmployeeRepository) GetEmployeeCredentials(ctx context.Context, username string) (model.Employee, error) {
+	path := "internal.repository.employee.GetEmployeeCredentials"
+	sql := `SELECT id,
+	               username,
+	               COALESCE(first_name, ''),
+	               COALESCE(last_name, ''),
+	               COALESCE(password_hash, ''),
+	               created_at,
+	               updated_at
+					FROM employee
+					WHERE username = $1`
+
+	var res model.Employee
+	err := eR.DB.Querier(ctx).QueryRow(ctx, sql, username).Scan(&res.ID,
+		&res.Username,
+		&res.FirstName,
+		&res.LastName,
+		&res.PasswordHash,
+		&res.CreatedAt,
+		&res.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Employee{}, custom_errors.ErrUserNotFound
+		}
+		return model.Employee{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (eR *EmployeeRepository) UpdateEmployee(ctx context.Context, employee model.Employee) (model.Employee, error) {
+	path := "internal.repository.employee.UpdateEmployee"
+
+	// Пустые значения не меняют соответствующие поля
+	sql := `UPDATE employee
+					SET first_name = COALESCE(NULLIF($2, ''), first_name),
+					    last_name = COALESCE(NULLIF($3, ''), last_name),
+					    updated_at = NOW()
+					WHERE username = $1
+					RETURNING id, username, COALESCE(first_name, ''), COALESCE(last_name, ''), created_at, updated_at`
+
+	var res model.Employee
+	err := eR.DB.Querier(ctx).QueryRow(ctx, sql,
+		employee.Username,
+		employee.FirstName,
+		employee.LastName).Scan(&res.ID,
+		&res.Username,
+		&res.FirstName,
+		&res.LastName,
+		&res.CreatedAt,
+		&res.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Employee{}, custom_errors.ErrUserNotFound
+		}
+		return model.Employee{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (eR *EmployeeRepository) DeleteEmployee(ctx context.Context, username string) error {
+	path := "internal.repository.employee.DeleteEmployee"
+	sql := `DELETE FROM employee WHERE username = $1`
+
+	tag, err := eR.DB.Querier(ctx).Exec(ctx, sql, username)
+	if err != nil {
+		return fmt.Errorf(path+".Exec, error: {%s}", err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return custom_errors.ErrUserNotFound
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/pkg/postgres"
+
+	"github.com/google/uuid"
+)
+
+type FeedbackRepository struct {
+	*postgres.DB
+}
+
+func NewFeedbackRepository(db *postgres.DB) *FeedbackRepository {
+	return &FeedbackRepository{db}
+}
+
+func (fR *FeedbackRepository) CreateBidFeedback(
+	ctx context.Context,
+	feedback model.BidFeedback,
+) (model.BidFeedback, error) {
+	path := "internal.repository.feedback.CreateBidFeedback"
+
+	sql := `INSERT INTO bid_feedback (bid_id, description, author_username)
+					VALUES ($1, $2, $3)
+					RETURNING id, bid_id, description, author_username, created_at`
+
+	var res model.BidFeedback
+	err := fR.DB.Querier(ctx).QueryRow(ctx, sql,
+		feedback.BidID,
+		feedback.Description,
+		feedback.AuthorUsername).Scan(&res.ID,
+		&res.BidID,
+		&res.Description,
+		&res.AuthorUsername,
+		&res.CreatedAt)
+	if err != nil {
+		return model.BidFeedback{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (fR *FeedbackRepository) HasAuthorBidOnTender(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	authorUsername string,
+) (bool, error) {
+	path := "internal.repository.feedback.HasAuthorBidOnTender"
+
+	sql := `SELECT EXISTS (SELECT 1 FROM bids WHERE tender_id = $1 AND creator_username = $2)`
+
+	var exists bool
+	err := fR.DB.Querier(ctx).QueryRow(ctx, sql, tenderId, authorUsername).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return exists, nil
+}
+
+func (fR *FeedbackRepository) GetAuthorReviews(
+	ctx context.Context,
+	authorUsername string,
+	limit, offset int,
+) ([]model.BidFeedback, error) {
+	path := "internal.repository.feedback.GetAuthorReviews"
+
+	sql := `SELECT f.id, f.bid_id, f.description, f.author_username, f.created_at
+					FROM bid_feedback f
+					JOIN bids b ON b.id = f.bid_id
+					WHERE b.creator_username = $1
+					ORDER BY f.created_at DESC
+					LIMIT $2 OFFSET $3`
+
+	rows, err := fR.DB.Querier(ctx).Query(ctx, sql, authorUsername, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	defer rows.Close()
+
+	res := make([]model.BidFeedback, 0)
+	for rows.Next() {
+		var feedback model.BidFeedback
+		err = rows.Scan(&feedback.ID,
+			&feedback.BidID,
+			&feedback.Description,
+			&feedback.AuthorUsername,
+			&feedback.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+		}
+		res = append(res, feedback)
+	}
+	if len(res) == 0 {
+		return nil, custom_errors.ErrFeedbackNotFound
+	}
+	return res, nil
+}
@@ -0,0 +1,400 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+
+	"github.com/google/uuid"
+)
+
+type BidsRepository struct {
+	*Store
+}
+
+func NewBidsRepository(store *Store) *BidsRepository {
+	return &BidsRepository{store}
+}
+
+func (bR *BidsRepository) CreateBids(ctx context.Context, bids *model.Bids) (model.Bids, error) {
+	var res model.Bids
+	err := bR.update(ctx, func() error {
+		if _, ok := bR.data.tenders[bids.TenderID]; !ok {
+			return custom_errors.ErrTenderNotFound
+		}
+		if _, ok := bR.data.organizations[bids.OrganizationID]; !ok {
+			return custom_errors.ErrOrganizationNotFound
+		}
+		now := bR.now()
+		res = *bids
+		res.ID = uuid.New()
+		res.Version = 1
+		res.CreatedAt = now
+		res.UpdatedAt = now
+		bR.data.bids[res.ID] = bidRow{Bids: res}
+		bR.saveBidVersion(res.ID)
+		return nil
+	})
+	return res, err
+}
+
+func (bR *BidsRepository) IsTenderValid(ctx context.Context, tenderID uuid.UUID) (bool, error) {
+	var valid bool
+	err := bR.view(ctx, func() error {
+		t, ok := bR.data.tenders[tenderID]
+		valid = ok && t.Status == model.TenderStatusPublished
+		return nil
+	})
+	return valid, err
+}
+
+func (bR *BidsRepository) GetBids(ctx context.Context, user string, p model.Page) (model.List[model.Bids], error) {
+	var res []model.Bids
+	err := bR.view(ctx, func() error {
+		for _, b := range bR.data.bids {
+			if b.CreatorUsername == user {
+				res = append(res, b.Bids)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return model.List[model.Bids]{}, err
+	}
+	return keysetPage(res, p), nil
+}
+
+func (bR *BidsRepository) GetBidsByTenderId(
+	ctx context.Context,
+	user string,
+	tenderId uuid.UUID,
+	p model.Page,
+) (model.List[model.Bids], error) {
+	var res []model.Bids
+	err := bR.view(ctx, func() error {
+		t, ok := bR.data.tenders[tenderId]
+		if !ok {
+			return custom_errors.ErrTenderNotFound
+		}
+		if !bR.tenderVisibleTo(t.Tender, user) {
+			return custom_errors.ErrAccessDenied
+		}
+		for _, b := range bR.data.bids {
+			if b.TenderID == tenderId && bR.bidVisibleTo(b.Bids, t.Tender, user) {
+				res = append(res, b.Bids)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return model.List[model.Bids]{}, err
+	}
+	return keysetPage(res, p), nil
+}
+
+func (bR *BidsRepository) UpdateBids(ctx context.Context, bids *model.Bids) (model.Bids, error) {
+	var res model.Bids
+	err := bR.update(ctx, func() error {
+		current, err := bR.lockBidVersion(bids.ID, bids.Version)
+		if err != nil {
+			return err
+		}
+		if bids.Title != "" {
+			current.Title = bids.Title
+		}
+		if bids.Description != "" {
+			current.Description = bids.Description
+		}
+		res = bR.bumpBid(current)
+		return nil
+	})
+	return res, err
+}
+
+func (bR *BidsRepository) GetBidStatus(ctx context.Context, bidId uuid.UUID, user string) (string, error) {
+	var status string
+	err := bR.view(ctx, func() error {
+		b, ok := bR.data.bids[bidId]
+		if !ok {
+			return custom_errors.ErrBidsNotFound
+		}
+		if !bR.bidVisibleTo(b.Bids, bR.data.tenders[b.TenderID].Tender, user) {
+			return custom_errors.ErrAccessDenied
+		}
+		status = b.Status
+		return nil
+	})
+	return status, err
+}
+
+func (bR *BidsRepository) UpdateBidsStatus(ctx context.Context, bids model.Bids) (model.Bids, error) {
+	var res model.Bids
+	err := bR.update(ctx, func() error {
+		current, err := bR.lockBidVersion(bids.ID, bids.Version)
+		if err != nil {
+			return err
+		}
+		current.Status = bids.Status
+		res = bR.bumpBid(current)
+		return nil
+	})
+	return res, err
+}
+
+func (bR *BidsRepository) UpdateBidsDecision(
+	ctx context.Context,
+	bidId uuid.UUID,
+	decision, username string,
+) (model.Bids, error) {
+	var res model.Bids
+	err := bR.update(ctx, func() error {
+		b, ok := bR.data.bids[bidId]
+		if !ok {
+			return custom_errors.ErrBidsNotFound
+		}
+		if b.Decision != "" {
+			return custom_errors.ErrDecisionAlreadyMade
+		}
+		// Черновик и отозванное предложение рецензенты не рассматривают
+		if b.Status != model.BidsStatusPublished {
+			return custom_errors.ErrBidNotPublished
+		}
+		t := bR.data.tenders[b.TenderID]
+		if t.Status == model.TenderStatusClosed {
+			return custom_errors.ErrTenderClosed
+		}
+
+		// Право голоса проверяет сервис, здесь считаем только размер кворума
+		reviewers := 0
+		for _, r := range bR.data.responsibles {
+			if r.OrganizationID == t.OrganizationID && r.Role.Allows(model.PermissionBidReview) {
+				reviewers++
+			}
+		}
+
+		approved, rejected := 0, 0
+		for _, d := range bR.data.decisions {
+			if d.BidID != bidId {
+				continue
+			}
+			if d.Username == username {
+				return custom_errors.ErrDecisionAlreadyMade
+			}
+			switch d.Decision {
+			case model.BidDecisionApproved:
+				approved++
+			case model.BidDecisionRejected:
+				rejected++
+			}
+		}
+		vote := model.BidDecision{
+			ID:        uuid.New(),
+			BidID:     bidId,
+			Username:  username,
+			Decision:  decision,
+			CreatedAt: bR.now(),
+		}
+		bR.data.decisions[vote.ID] = vote
+		switch decision {
+		case model.BidDecisionApproved:
+			approved++
+		case model.BidDecisionRejected:
+			rejected++
+		}
+
+		// Одного отказа достаточно для отклонения, для согласования нужен кворум min(3, число рецензентов)
+		outcome := ""
+		switch {
+		case rejected > 0:
+			outcome = model.BidDecisionRejected
+		case approved >= min(3, reviewers):
+			outcome = model.BidDecisionApproved
+		}
+		if outcome != "" {
+			b.Decision = outcome
+			bR.bumpBid(b)
+		}
+		if outcome == model.BidDecisionApproved {
+			err := bR.awardTender(t, bidId)
+			if err != nil {
+				return err
+			}
+		}
+
+		res = bR.data.bids[bidId].Bids
+		return nil
+	})
+	return res, err
+}
+
+// awardTender закрывает тендер в пользу согласованного предложения,
+// а остальные предложения без решения помечает проигравшими. Статус предложения
+// перечитывается под той же блокировкой, что и запись тендера.
+func (s *Store) awardTender(t tenderRow, bidId uuid.UUID) error {
+	if s.data.bids[bidId].Status != model.BidsStatusPublished {
+		return custom_errors.ErrBidNotPublished
+	}
+	t.Status = model.TenderStatusClosed
+	t.ClosedBy = model.ClosedByAward
+	t.AwardedBidID = bidId
+	s.bumpTender(t)
+	s.markBidsLost(t.ID, bidId)
+	return nil
+}
+
+// markBidsLost помечает проигравшими все предложения тендера без решения, кроме exceptBidId.
+func (s *Store) markBidsLost(tenderId, exceptBidId uuid.UUID) {
+	for id, b := range s.data.bids {
+		if b.TenderID == tenderId && id != exceptBidId && b.Decision == "" {
+			b.Decision = model.BidDecisionLost
+			s.bumpBid(b)
+		}
+	}
+}
+
+func (bR *BidsRepository) GetBidDecisions(ctx context.Context, bidId uuid.UUID) ([]model.BidDecision, error) {
+	res := make([]model.BidDecision, 0)
+	err := bR.view(ctx, func() error {
+		for _, d := range bR.data.decisions {
+			if d.BidID == bidId {
+				res = append(res, d)
+			}
+		}
+		return nil
+	})
+	sort.Slice(res, func(i, j int) bool { return res[i].CreatedAt.Before(res[j].CreatedAt) })
+	return res, err
+}
+
+func (bR *BidsRepository) GetBidVersions(
+	ctx context.Context,
+	bidId uuid.UUID,
+	limit, offset int,
+) ([]model.Bids, error) {
+	var res []model.Bids
+	err := bR.view(ctx, func() error {
+		res = slices.Clone(bR.data.bidVersions[bidId])
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Version > res[j].Version })
+	return page(res, limit, offset), nil
+}
+
+func (bR *BidsRepository) GetBidVersion(ctx context.Context, bidId uuid.UUID, version int) (model.Bids, error) {
+	var res model.Bids
+	err := bR.view(ctx, func() error {
+		snapshot, ok := bR.bidVersion(bidId, version)
+		if !ok {
+			return custom_errors.ErrVersionNotFound
+		}
+		res = snapshot
+		return nil
+	})
+	return res, err
+}
+
+func (bR *BidsRepository) RollbackBids(
+	ctx context.Context,
+	bidId uuid.UUID,
+	version, expectedVersion int,
+) (model.Bids, error) {
+	var res model.Bids
+	err := bR.update(ctx, func() error {
+		current, err := bR.lockBidVersion(bidId, expectedVersion)
+		if err != nil {
+			return err
+		}
+		snapshot, ok := bR.bidVersion(bidId, version)
+		if !ok {
+			return custom_errors.ErrVersionNotFound
+		}
+		// Откат считается новой правкой: параметры берутся из снимка, версия увеличивается
+		current.Title = snapshot.Title
+		current.Description = snapshot.Description
+		res = bR.bumpBid(current)
+		return nil
+	})
+	return res, err
+}
+
+func (bR *BidsRepository) GetBidById(ctx context.Context, bidId uuid.UUID) (model.Bids, error) {
+	var res model.Bids
+	err := bR.view(ctx, func() error {
+		b, ok := bR.data.bids[bidId]
+		if !ok {
+			return custom_errors.ErrBidsNotFound
+		}
+		res = b.Bids
+		return nil
+	})
+	return res, err
+}
+
+// LockBid только проверяет, что предложение существует: внутри WithinTx хранилище и так заблокировано целиком.
+func (bR *BidsRepository) LockBid(ctx context.Context, bidId uuid.UUID) error {
+	_, err := bR.GetBidById(ctx, bidId)
+	return err
+}
+
+// lockBidVersion возвращает предложение, если его версия совпадает с ожидаемой. Нулевая версия не проверяется.
+func (s *Store) lockBidVersion(bidId uuid.UUID, expected int) (bidRow, error) {
+	current, ok := s.data.bids[bidId]
+	if !ok {
+		return bidRow{}, custom_errors.ErrBidsNotFound
+	}
+	if expected != 0 && current.Version != expected {
+		return bidRow{}, custom_errors.ErrVersionConflict
+	}
+	return current, nil
+}
+
+// bumpBid сохраняет правку предложения: увеличивает версию и записывает снимок.
+func (s *Store) bumpBid(b bidRow) model.Bids {
+	b.Version++
+	b.UpdatedAt = s.now()
+	s.data.bids[b.ID] = b
+	s.saveBidVersion(b.ID)
+	return b.Bids
+}
+
+func (s *Store) saveBidVersion(bidId uuid.UUID) {
+	snapshot := s.data.bids[bidId].Bids
+	snapshot.CreatedAt = s.now()
+	s.data.bidVersions[bidId] = append(s.data.bidVersions[bidId], snapshot)
+}
+
+func (s *Store) bidVersion(bidId uuid.UUID, version int) (model.Bids, bool) {
+	for _, snapshot := range s.data.bidVersions[bidId] {
+		if snapshot.Version == version {
+			return snapshot, true
+		}
+	}
+	return model.Bids{}, false
+}
+
+// deleteBid удаляет предложение вместе с его версиями, отзывами и голосами.
+func (s *Store) deleteBid(bidId uuid.UUID) {
+	delete(s.data.bids, bidId)
+	delete(s.data.bidVersions, bidId)
+	for id, f := range s.data.feedback {
+		if f.BidID == bidId {
+			delete(s.data.feedback, id)
+		}
+	}
+	for id, d := range s.data.decisions {
+		if d.BidID == bidId {
+			delete(s.data.decisions, id)
+		}
+	}
+	for id, t := range s.data.tenders {
+		if t.AwardedBidID == bidId {
+			t.AwardedBidID = uuid.Nil
+			s.data.tenders[id] = t
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+
+	"github.com/google/uuid"
+)
+
+type EmployeeRepository struct {
+	*Store
+}
+
+func NewEmployeeRepository(store *Store) *EmployeeRepository {
+	return &EmployeeRepository{store}
+}
+
+func (eR *EmployeeRepository) CreateEmployee(ctx context.Context, employee model.Employee) (model.Employee, error) {
+	var res model.Employee
+	err := eR.update(ctx, func() error {
+		if _, ok := eR.employeeByUsername(employee.Username); ok {
+			return custom_errors.ErrEmployeeAlreadyExists
+		}
+		now := eR.now()
+		employee.ID = uuid.New()
+		employee.CreatedAt = now
+		employee.UpdatedAt = now
+		eR.data.employees[employee.ID] = employee
+
+		res = withoutPassword(employee)
+		return nil
+	})
+	return res, err
+}
+
+func (eR *EmployeeRepository) GetEmployees(ctx context.Context, limit, offset int) ([]model.Employee, error) {
+	res := make([]model.Employee, 0)
+	err := eR.view(ctx, func() error {
+		for _, employee := range eR.data.employees {
+			res = append(res, withoutPassword(employee))
+		}
+		return nil
+	})
+	sort.Slice(res, func(i, j int) bool { return res[i].Username < res[j].Username })
+	return page(res, limit, offset), err
+}
+
+func (eR *EmployeeRepository) GetEmployee(ctx context.Context, username string) (model.Employee, error) {
+	res, err := eR.GetEmployeeCredentials(ctx, username)
+	return withoutPassword(res), err
+}
+
+func (eR *EmployeeRepository) GetEmployeeCredentials(ctx context.Context, username string) (model.Employee, error) {
+	var res model.Employee
+	err := eR.view(ctx, func() error {
+		employee, ok := eR.employeeByUsername(username)
+		if !ok {
+			return custom_errors.ErrUserNotFound
+		}
+		res = employee
+		return nil
+	})
+	return res, err
+}
+
+func (eR *EmployeeRepository) UpdateEmployee(ctx context.Context, employee model.Employee) (model.Employee, error) {
+	var res model.Employee
+	err := eR.update(ctx, func() error {
+		current, ok := eR.employeeByUsername(employee.Username)
+		if !ok {
+			return custom_errors.ErrUserNotFound
+		}
+		// Пустые значения не меняют соответствующие поля
+		if employee.FirstName != "" {
+			current.FirstName = employee.FirstName
+		}
+		if employee.LastName != "" {
+			current.LastName = employee.LastName
+		}
+		current.UpdatedAt = eR.now()
+		eR.data.employees[current.ID] = current
+
+		res = withoutPassword(current)
+		return nil
+	})
+	return res, err
+}
+
+func (eR *EmployeeRepository) DeleteEmployee(ctx context.Context, username string) error {
+	return eR.update(ctx, func() error {
+		employee, ok := eR.employeeByUsername(username)
+		if !ok {
+			return custom_errors.ErrUserNotFound
+		}
+		delete(eR.data.employees, employee.ID)
+		for id, r := range eR.data.responsibles {
+			if r.UserID == employee.ID {
+				delete(eR.data.responsibles, id)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) employeeByUsername(username string) (model.Employee, bool) {
+	for _, employee := range s.data.employees {
+		if employee.Username == username {
+			return employee, true
+		}
+	}
+	return model.Employee{}, false
+}
+
+func withoutPassword(employee model.Employee) model.Employee {
+	employee.PasswordHash = ""
+	return employee
+}
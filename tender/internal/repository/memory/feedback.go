@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+
+	"github.com/google/uuid"
+)
+
+type FeedbackRepository struct {
+	*Store
+}
+
+func NewFeedbackRepository(store *Store) *FeedbackRepository {
+	return &FeedbackRepository{store}
+}
+
+func (fR *FeedbackRepository) CreateBidFeedback(
+	ctx context.Context,
+	feedback model.BidFeedback,
+) (model.BidFeedback, error) {
+	err := fR.update(ctx, func() error {
+		if _, ok := fR.data.bids[feedback.BidID]; !ok {
+			return custom_errors.ErrBidsNotFound
+		}
+		feedback.ID = uuid.New()
+		feedback.CreatedAt = fR.now()
+		fR.data.feedback[feedback.ID] = feedback
+		return nil
+	})
+	if err != nil {
+		return model.BidFeedback{}, err
+	}
+	return feedback, nil
+}
+
+func (fR *FeedbackRepository) HasAuthorBidOnTender(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	authorUsername string,
+) (bool, error) {
+	var exists bool
+	err := fR.view(ctx, func() error {
+		for _, b := range fR.data.bids {
+			if b.TenderID == tenderId && b.CreatorUsername == authorUsername {
+				exists = true
+				break
+			}
+		}
+		return nil
+	})
+	return exists, err
+}
+
+func (fR *FeedbackRepository) GetAuthorReviews(
+	ctx context.Context,
+	authorUsername string,
+	limit, offset int,
+) ([]model.BidFeedback, error) {
+	var res []model.BidFeedback
+	err := fR.view(ctx, func() error {
+		for _, f := range fR.data.feedback {
+			if fR.data.bids[f.BidID].CreatorUsername == authorUsername {
+				res = append(res, f)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].CreatedAt.After(res[j].CreatedAt) })
+	res = page(res, limit, offset)
+	if len(res) == 0 {
+		return nil, custom_errors.ErrFeedbackNotFound
+	}
+	return res, nil
+}
@@ -0,0 +1,303 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationRepository struct {
+	*Store
+}
+
+func NewOrganizationRepository(store *Store) *OrganizationRepository {
+	return &OrganizationRepository{store}
+}
+
+func (oR *OrganizationRepository) CreateOrganization(
+	ctx context.Context,
+	organization model.Organization,
+	responsibleUsername string,
+) (model.Organization, error) {
+	var res model.Organization
+	err := oR.update(ctx, func() error {
+		now := oR.now()
+		res = model.Organization{
+			ID:          uuid.New(),
+			Name:        organization.Name,
+			Description: organization.Description,
+			Type:        organization.Type,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		oR.data.organizations[res.ID] = res
+
+		// Создатель организации становится ее первым владельцем
+		return oR.addResponsible(res.ID, responsibleUsername, model.OrganizationRoleOwner)
+	})
+	return res, err
+}
+
+func (oR *OrganizationRepository) GetOrganizations(
+	ctx context.Context,
+	limit, offset int,
+) ([]model.Organization, error) {
+	res := make([]model.Organization, 0)
+	err := oR.view(ctx, func() error {
+		for _, organization := range oR.data.organizations {
+			res = append(res, organization)
+		}
+		return nil
+	})
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Name != res[j].Name {
+			return res[i].Name < res[j].Name
+		}
+		return bytes.Compare(res[i].ID[:], res[j].ID[:]) < 0
+	})
+	return page(res, limit, offset), err
+}
+
+func (oR *OrganizationRepository) GetOrganization(
+	ctx context.Context,
+	organizationId uuid.UUID,
+) (model.Organization, error) {
+	var res model.Organization
+	err := oR.view(ctx, func() error {
+		organization, ok := oR.data.organizations[organizationId]
+		if !ok {
+			return custom_errors.ErrOrganizationNotFound
+		}
+		res = organization
+		return nil
+	})
+	return res, err
+}
+
+func (oR *OrganizationRepository) UpdateOrganization(
+	ctx context.Context,
+	organization model.Organization,
+) (model.Organization, error) {
+	var res model.Organization
+	err := oR.update(ctx, func() error {
+		current, ok := oR.data.organizations[organization.ID]
+		if !ok {
+			return custom_errors.ErrOrganizationNotFound
+		}
+		// Пустые значения не меняют соответствующие поля
+		if organization.Name != "" {
+			current.Name = organization.Name
+		}
+		if organization.Description != "" {
+			current.Description = organization.Description
+		}
+		if organization.Type != "" {
+			current.Type = organization.Type
+		}
+		current.UpdatedAt = oR.now()
+		oR.data.organizations[current.ID] = current
+
+		res = current
+		return nil
+	})
+	return res, err
+}
+
+func (oR *OrganizationRepository) DeleteOrganization(ctx context.Context, organizationId uuid.UUID) error {
+	return oR.update(ctx, func() error {
+		if _, ok := oR.data.organizations[organizationId]; !ok {
+			return custom_errors.ErrOrganizationNotFound
+		}
+		delete(oR.data.organizations, organizationId)
+
+		// Каскадное удаление, как у внешних ключей с ON DELETE CASCADE
+		for id, r := range oR.data.responsibles {
+			if r.OrganizationID == organizationId {
+				delete(oR.data.responsibles, id)
+			}
+		}
+		for id, t := range oR.data.tenders {
+			if t.OrganizationID == organizationId {
+				oR.deleteTender(id)
+			}
+		}
+		for id, b := range oR.data.bids {
+			if b.OrganizationID == organizationId {
+				oR.deleteBid(id)
+			}
+		}
+		return nil
+	})
+}
+
+// GetMemberRole возвращает роль сотрудника в организации или пустую роль, если он в нее не входит.
+func (oR *OrganizationRepository) GetMemberRole(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	username string,
+) (model.OrganizationRole, error) {
+	var role model.OrganizationRole
+	err := oR.view(ctx, func() error {
+		employee, ok := oR.employeeByUsername(username)
+		if !ok {
+			return custom_errors.ErrUserNotFound
+		}
+		if _, ok = oR.data.organizations[organizationId]; !ok {
+			return custom_errors.ErrOrganizationNotFound
+		}
+		if r, ok := oR.responsible(organizationId, employee.ID); ok {
+			role = r.Role
+		}
+		return nil
+	})
+	return role, err
+}
+
+func (oR *OrganizationRepository) GetEmployeeRoles(
+	ctx context.Context,
+	username string,
+) ([]model.OrganizationResponsible, error) {
+	res := make([]model.OrganizationResponsible, 0)
+	err := oR.view(ctx, func() error {
+		employee, ok := oR.employeeByUsername(username)
+		if !ok {
+			return nil
+		}
+		for _, r := range oR.data.responsibles {
+			if r.UserID == employee.ID {
+				res = append(res, r)
+			}
+		}
+		return nil
+	})
+	sort.Slice(res, func(i, j int) bool {
+		return bytes.Compare(res[i].OrganizationID[:], res[j].OrganizationID[:]) < 0
+	})
+	return res, err
+}
+
+func (oR *OrganizationRepository) GetResponsibles(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	limit, offset int,
+) ([]model.OrganizationResponsible, error) {
+	res := make([]model.OrganizationResponsible, 0)
+	err := oR.view(ctx, func() error {
+		for _, r := range oR.data.responsibles {
+			if r.OrganizationID == organizationId {
+				r.Employee = withoutPassword(oR.data.employees[r.UserID])
+				res = append(res, r)
+			}
+		}
+		return nil
+	})
+	sort.Slice(res, func(i, j int) bool { return res[i].Employee.Username < res[j].Employee.Username })
+	return page(res, limit, offset), err
+}
+
+func (oR *OrganizationRepository) AddResponsible(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	username string,
+	role model.OrganizationRole,
+) error {
+	return oR.update(ctx, func() error {
+		return oR.addResponsible(organizationId, username, role)
+	})
+}
+
+func (oR *OrganizationRepository) UpdateResponsibleRole(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	username string,
+	role model.OrganizationRole,
+) error {
+	return oR.update(ctx, func() error {
+		current, ok := oR.responsibleByUsername(organizationId, username)
+		if !ok {
+			return custom_errors.ErrResponsibleNotFound
+		}
+		if current.Role == model.OrganizationRoleOwner && role != model.OrganizationRoleOwner &&
+			oR.countOwners(organizationId) <= 1 {
+			return custom_errors.ErrLastOwner
+		}
+		current.Role = role
+		oR.data.responsibles[current.ID] = current
+		return nil
+	})
+}
+
+func (oR *OrganizationRepository) RemoveResponsible(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	username string,
+) error {
+	return oR.update(ctx, func() error {
+		current, ok := oR.responsibleByUsername(organizationId, username)
+		if !ok {
+			return custom_errors.ErrResponsibleNotFound
+		}
+		if current.Role == model.OrganizationRoleOwner && oR.countOwners(organizationId) <= 1 {
+			return custom_errors.ErrLastOwner
+		}
+		delete(oR.data.responsibles, current.ID)
+		return nil
+	})
+}
+
+// addResponsible проверяет то же, что ограничения таблицы organization_responsible:
+// сотрудник и организация существуют, пара уникальна.
+func (s *Store) addResponsible(organizationId uuid.UUID, username string, role model.OrganizationRole) error {
+	employee, ok := s.employeeByUsername(username)
+	if !ok {
+		return custom_errors.ErrEmployeeNotFound
+	}
+	if _, ok = s.data.organizations[organizationId]; !ok {
+		return custom_errors.ErrOrganizationNotFound
+	}
+	if _, ok = s.responsible(organizationId, employee.ID); ok {
+		return custom_errors.ErrResponsibleAlreadyExists
+	}
+	id := uuid.New()
+	s.data.responsibles[id] = model.OrganizationResponsible{
+		ID:             id,
+		OrganizationID: organizationId,
+		UserID:         employee.ID,
+		Role:           role,
+	}
+	return nil
+}
+
+func (s *Store) responsible(organizationId, userId uuid.UUID) (model.OrganizationResponsible, bool) {
+	for _, r := range s.data.responsibles {
+		if r.OrganizationID == organizationId && r.UserID == userId {
+			return r, true
+		}
+	}
+	return model.OrganizationResponsible{}, false
+}
+
+func (s *Store) responsibleByUsername(
+	organizationId uuid.UUID,
+	username string,
+) (model.OrganizationResponsible, bool) {
+	employee, ok := s.employeeByUsername(username)
+	if !ok {
+		return model.OrganizationResponsible{}, false
+	}
+	return s.responsible(organizationId, employee.ID)
+}
+
+func (s *Store) countOwners(organizationId uuid.UUID) int {
+	owners := 0
+	for _, r := range s.data.responsibles {
+		if r.OrganizationID == organizationId && r.Role == model.OrganizationRoleOwner {
+			owners++
+		}
+	}
+	return owners
+}
@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+
+	"github.com/google/uuid"
+)
+
+type PublicationRepository struct {
+	*Store
+}
+
+func NewPublicationRepository(store *Store) *PublicationRepository {
+	return &PublicationRepository{store}
+}
+
+func (pR *PublicationRepository) SavePublication(
+	ctx context.Context,
+	publication model.TenderPublication,
+) (model.TenderPublication, error) {
+	err := pR.update(ctx, func() error {
+		if _, ok := pR.data.tenders[publication.TenderID]; !ok {
+			return custom_errors.ErrTenderNotFound
+		}
+		publication.Status = model.PublicationPending
+		publication.Error = ""
+		publication.UpdatedAt = pR.now()
+		pR.data.publications[publication.TenderID] = publication
+		return nil
+	})
+	if err != nil {
+		return model.TenderPublication{}, err
+	}
+	return publication, nil
+}
+
+func (pR *PublicationRepository) GetPublication(
+	ctx context.Context,
+	tenderId uuid.UUID,
+) (model.TenderPublication, error) {
+	var res model.TenderPublication
+	err := pR.view(ctx, func() error {
+		publication, ok := pR.data.publications[tenderId]
+		if !ok {
+			return custom_errors.ErrPublicationNotFound
+		}
+		res = publication
+		return nil
+	})
+	return res, err
+}
+
+func (pR *PublicationRepository) SetPublicationStatus(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	status, errorCode string,
+) (model.TenderPublication, error) {
+	var res model.TenderPublication
+	err := pR.update(ctx, func() error {
+		publication, ok := pR.data.publications[tenderId]
+		if !ok {
+			return custom_errors.ErrPublicationNotFound
+		}
+		publication.Status = status
+		publication.Error = errorCode
+		publication.UpdatedAt = pR.now()
+		pR.data.publications[tenderId] = publication
+		res = publication
+		return nil
+	})
+	return res, err
+}
+
+func (pR *PublicationRepository) GetDuePublications(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]uuid.UUID, error) {
+	var due []model.TenderPublication
+	err := pR.view(ctx, func() error {
+		for _, p := range pR.data.publications {
+			if p.Status == model.PublicationPending && !p.PublishAt.After(now) {
+				due = append(due, p)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].PublishAt.Before(due[j].PublishAt) })
+	ids := make([]uuid.UUID, 0, min(len(due), limit))
+	for _, p := range page(due, limit, 0) {
+		ids = append(ids, p.TenderID)
+	}
+	return ids, nil
+}
@@ -0,0 +1,19 @@
+package memory
+
+import "zadanie-6105/internal/repository"
+
+// NewRepositories собирает все репозитории поверх одного хранилища в памяти.
+// Используется в режиме STORAGE=memory и в тестах.
+func NewRepositories() *repository.Repositories {
+	store := NewStore()
+	return &repository.Repositories{
+		Transactor:    store,
+		ITender:       NewTenderRepository(store),
+		IBids:         NewBidsRepository(store),
+		IFeedback:     NewFeedbackRepository(store),
+		IOrganization: NewOrganizationRepository(store),
+		IEmployee:     NewEmployeeRepository(store),
+		ISearch:       NewSearchRepository(store),
+		IPublication:  NewPublicationRepository(store),
+	}
+}
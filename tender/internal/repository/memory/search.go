@@ -0,0 +1,155 @@
+package memory
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"zadanie-6105/internal/model"
+)
+
+// Веса совпадений в названии и описании — как веса A и B в ts_rank у Postgres.
+const (
+	titleWeight       = 1.0
+	descriptionWeight = 0.4
+
+	snippetWords = 25
+)
+
+var searchWord = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+type SearchRepository struct {
+	*Store
+}
+
+func NewSearchRepository(store *Store) *SearchRepository {
+	return &SearchRepository{store}
+}
+
+// SearchTenders приближенно повторяет полнотекстовый поиск Postgres: слова сравниваются по грубой основе
+// (без окончания), все слова запроса должны найтись, а слова с минусом — отсутствовать. Фразы и or
+// не поддерживаются; для проверки ранжирования и сниппетов в тестах этого достаточно.
+func (sR *SearchRepository) SearchTenders(
+	ctx context.Context,
+	user string,
+	search model.TenderSearch,
+) ([]model.TenderSearchResult, error) {
+	include, exclude := parseSearchQuery(search.Query)
+	res := make([]model.TenderSearchResult, 0)
+	if len(include) == 0 {
+		return res, nil
+	}
+	err := sR.view(ctx, func() error {
+		for _, t := range sR.data.tenders {
+			if !sR.tenderVisibleTo(t.Tender, user) {
+				continue
+			}
+			if len(search.ServiceTypes) > 0 && !slices.Contains(search.ServiceTypes, t.ServiceType) {
+				continue
+			}
+			rank, ok := searchRank(t.Tender, include, exclude)
+			if !ok {
+				continue
+			}
+			res = append(res, model.TenderSearchResult{
+				Tender:  t.Tender,
+				Rank:    rank,
+				Snippet: snippet(t.Title+"\n"+t.Description, include),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Rank != res[j].Rank {
+			return res[i].Rank > res[j].Rank
+		}
+		return res[j].Tender.Cursor().Less(res[i].Tender.Cursor())
+	})
+	return page(res, search.Limit, search.Offset), nil
+}
+
+func parseSearchQuery(query string) (include, exclude []string) {
+	for _, field := range strings.Fields(query) {
+		excluded := strings.HasPrefix(field, "-")
+		for _, word := range searchWord.FindAllString(field, -1) {
+			if excluded {
+				exclude = append(exclude, stem(word))
+			} else {
+				include = append(include, stem(word))
+			}
+		}
+	}
+	return include, exclude
+}
+
+// searchRank складывает веса совпавших слов запроса. Тендер не подходит, если какого-то слова нет
+// или есть исключенное.
+func searchRank(tender model.Tender, include, exclude []string) (float64, bool) {
+	title, description := stems(tender.Title), stems(tender.Description)
+	for _, word := range exclude {
+		if title[word] > 0 || description[word] > 0 {
+			return 0, false
+		}
+	}
+	var rank float64
+	for _, word := range include {
+		if title[word] == 0 && description[word] == 0 {
+			return 0, false
+		}
+		rank += titleWeight*float64(title[word]) + descriptionWeight*float64(description[word])
+	}
+	return rank, true
+}
+
+func stems(text string) map[string]int {
+	res := make(map[string]int)
+	for _, word := range searchWord.FindAllString(text, -1) {
+		res[stem(word)]++
+	}
+	return res
+}
+
+// stem отбрасывает гласные, мягкий и твердый знаки и s в конце слова, оставляя не меньше трех букв:
+// «Казань» и «Казани» дают «казан».
+func stem(word string) string {
+	runes := []rune(strings.ToLower(word))
+	for len(runes) > 3 && strings.ContainsRune("аеёиоуыэюяйьъaeiouys", runes[len(runes)-1]) {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes)
+}
+
+// snippet отмечает совпавшие слова так же, как ts_headline, и обрезает текст до snippetWords слов,
+// начиная за пару слов до первого совпадения.
+func snippet(text string, include []string) string {
+	words := searchWord.FindAllStringIndex(text, -1)
+	first := -1
+	for i, w := range words {
+		if slices.Contains(include, stem(text[w[0]:w[1]])) {
+			first = i
+			break
+		}
+	}
+	from := max(first-2, 0)
+	to := min(from+snippetWords, len(words))
+	if from >= to {
+		return ""
+	}
+
+	var b strings.Builder
+	prev := words[from][0]
+	for _, w := range words[from:to] {
+		b.WriteString(text[prev:w[0]])
+		word := text[w[0]:w[1]]
+		if slices.Contains(include, stem(word)) {
+			word = model.HighlightStart + word + model.HighlightStop
+		}
+		b.WriteString(word)
+		prev = w[1]
+	}
+	return b.String()
+}
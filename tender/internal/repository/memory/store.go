@@ -0,0 +1,182 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+	"zadanie-6105/internal/model"
+
+	"github.com/google/uuid"
+)
+
+type txKey struct{}
+
+// Store хранит все таблицы в памяти процесса и повторяет поведение Postgres-репозиториев:
+// версии, проверки видимости, пагинацию и транзакции. Данные живут до перезапуска.
+type Store struct {
+	mu       sync.RWMutex
+	data     tables
+	lastTime time.Time
+}
+
+type tables struct {
+	employees      map[uuid.UUID]model.Employee
+	organizations  map[uuid.UUID]model.Organization
+	responsibles   map[uuid.UUID]model.OrganizationResponsible
+	tenders        map[uuid.UUID]tenderRow
+	tenderVersions map[uuid.UUID][]model.Tender
+	bids           map[uuid.UUID]bidRow
+	bidVersions    map[uuid.UUID][]model.Bids
+	feedback       map[uuid.UUID]model.BidFeedback
+	decisions      map[uuid.UUID]model.BidDecision
+	publications   map[uuid.UUID]model.TenderPublication
+}
+
+// tenderRow и bidRow — строки таблиц с колонками, которых нет в модели.
+type tenderRow struct {
+	model.Tender
+	AwardedBidID uuid.UUID
+}
+type bidRow struct {
+	model.Bids
+	Decision string
+}
+
+func NewStore() *Store {
+	return &Store{
+		data: tables{
+			employees:      make(map[uuid.UUID]model.Employee),
+			organizations:  make(map[uuid.UUID]model.Organization),
+			responsibles:   make(map[uuid.UUID]model.OrganizationResponsible),
+			tenders:        make(map[uuid.UUID]tenderRow),
+			tenderVersions: make(map[uuid.UUID][]model.Tender),
+			bids:           make(map[uuid.UUID]bidRow),
+			bidVersions:    make(map[uuid.UUID][]model.Bids),
+			feedback:       make(map[uuid.UUID]model.BidFeedback),
+			decisions:      make(map[uuid.UUID]model.BidDecision),
+			publications:   make(map[uuid.UUID]model.TenderPublication),
+		},
+	}
+}
+
+// clone копирует таблицы для отката. Срезы версий только дополняются, поэтому их достаточно скопировать по ссылке.
+func (t tables) clone() tables {
+	return tables{
+		employees:      maps.Clone(t.employees),
+		organizations:  maps.Clone(t.organizations),
+		responsibles:   maps.Clone(t.responsibles),
+		tenders:        maps.Clone(t.tenders),
+		tenderVersions: maps.Clone(t.tenderVersions),
+		bids:           maps.Clone(t.bids),
+		bidVersions:    maps.Clone(t.bidVersions),
+		feedback:       maps.Clone(t.feedback),
+		decisions:      maps.Clone(t.decisions),
+		publications:   maps.Clone(t.publications),
+	}
+}
+
+// WithinTx выполняет fn под исключительной блокировкой хранилища. Если fn вернула ошибку,
+// таблицы возвращаются к состоянию до вызова. Вложенный вызов переиспользует внешнюю транзакцию.
+func (s *Store) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.inTx(ctx) {
+		return fn(ctx)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := s.data.clone()
+	err := fn(context.WithValue(ctx, txKey{}, s))
+	if err != nil {
+		s.data = snapshot
+	}
+	return err
+}
+
+func (s *Store) inTx(ctx context.Context) bool {
+	return ctx.Value(txKey{}) == s
+}
+
+// update выполняет изменение атомарно: при ошибке fn все его правки отменяются,
+// как при откате транзакции репозитория. Внутри WithinTx блокировка уже взята.
+func (s *Store) update(ctx context.Context, fn func() error) error {
+	if !s.inTx(ctx) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	}
+	snapshot := s.data.clone()
+	err := fn()
+	if err != nil {
+		s.data = snapshot
+	}
+	return err
+}
+
+// view выполняет чтение под разделяемой блокировкой.
+func (s *Store) view(ctx context.Context, fn func() error) error {
+	if !s.inTx(ctx) {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+	}
+	return fn()
+}
+
+// now возвращает строго возрастающее время с точностью TIMESTAMP в Postgres,
+// чтобы сортировка по времени создания была однозначной. Вызывается только внутри update.
+func (s *Store) now() time.Time {
+	t := time.Now().UTC().Truncate(time.Microsecond)
+	if !t.After(s.lastTime) {
+		t = s.lastTime.Add(time.Microsecond)
+	}
+	s.lastTime = t
+	return t
+}
+
+// page применяет LIMIT и OFFSET к уже отсортированной выборке.
+func page[T any](items []T, limit, offset int) []T {
+	if offset >= len(items) {
+		return items[:0]
+	}
+	items = items[offset:]
+	if limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+// keysetPage повторяет keysetPage из Postgres-репозиториев: сортирует выборку по (created_at, id)
+// от новых к старым, пропускает записи до курсора и применяет LIMIT, а OFFSET — только без курсора.
+func keysetPage[T interface{ Cursor() model.Cursor }](items []T, p model.Page) model.List[T] {
+	sort.Slice(items, func(i, j int) bool { return items[j].Cursor().Less(items[i].Cursor()) })
+	var total *int
+	if p.WithTotal {
+		n := len(items)
+		total = &n
+	}
+	offset := p.Offset
+	if p.After != nil {
+		offset = sort.Search(len(items), func(i int) bool { return items[i].Cursor().Less(*p.After) })
+	}
+	list := model.NewList(page(items, p.Limit, offset), p)
+	list.Total = total
+	return list
+}
+
+// sortedPage повторяет sortedPage из Postgres-репозиториев: сортирует по compare, при равенстве — от новых
+// к старым, и листает через OFFSET без курсора.
+func sortedPage[T interface{ Cursor() model.Cursor }](items []T, p model.Page, compare func(a, b T) int) model.List[T] {
+	sort.Slice(items, func(i, j int) bool {
+		if c := compare(items[i], items[j]); c != 0 {
+			return c < 0
+		}
+		return items[j].Cursor().Less(items[i].Cursor())
+	})
+	list := model.NewList(page(items, p.Limit, p.Offset), p)
+	list.Next = nil
+	if p.WithTotal {
+		n := len(items)
+		list.Total = &n
+	}
+	return list
+}
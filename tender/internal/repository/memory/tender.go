@@ -0,0 +1,310 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+
+	"github.com/google/uuid"
+)
+
+type TenderRepository struct {
+	*Store
+}
+
+func NewTenderRepository(store *Store) *TenderRepository {
+	return &TenderRepository{store}
+}
+
+// tenderSortKeys повторяет белый список сортировки Postgres-репозитория.
+var tenderSortKeys = map[string]func(a, b model.Tender) int{
+	"name":        func(a, b model.Tender) int { return strings.Compare(a.Title, b.Title) },
+	"createdAt":   func(a, b model.Tender) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	"version":     func(a, b model.Tender) int { return cmp.Compare(a.Version, b.Version) },
+	"status":      func(a, b model.Tender) int { return strings.Compare(a.Status, b.Status) },
+	"serviceType": func(a, b model.Tender) int { return strings.Compare(a.ServiceType, b.ServiceType) },
+}
+
+func (tR *TenderRepository) GetTenders(
+	ctx context.Context,
+	user string,
+	p model.Page,
+	filter model.TenderFilter,
+) (model.List[model.Tender], error) {
+	compare, err := tenderOrder(filter.Sort)
+	if err != nil {
+		return model.List[model.Tender]{}, err
+	}
+	var tenders []model.Tender
+	err = tR.view(ctx, func() error {
+		for _, t := range tR.data.tenders {
+			if tR.tenderVisibleTo(t.Tender, user) && tenderMatches(t.Tender, filter) {
+				tenders = append(tenders, t.Tender)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return model.List[model.Tender]{}, err
+	}
+	if len(filter.Sort) > 0 {
+		return sortedPage(tenders, p, compare), nil
+	}
+	return keysetPage(tenders, p), nil
+}
+
+func tenderMatches(t model.Tender, filter model.TenderFilter) bool {
+	return (len(filter.ServiceTypes) == 0 || slices.Contains(filter.ServiceTypes, t.ServiceType)) &&
+		(len(filter.Statuses) == 0 || slices.Contains(filter.Statuses, t.Status)) &&
+		(filter.OrganizationID == uuid.Nil || t.OrganizationID == filter.OrganizationID) &&
+		(filter.CreatedFrom.IsZero() || !t.CreatedAt.Before(filter.CreatedFrom)) &&
+		(filter.CreatedTo.IsZero() || t.CreatedAt.Before(filter.CreatedTo)) &&
+		(filter.Version == 0 || t.Version == filter.Version) &&
+		(filter.CreatorUsername == "" || t.CreatorUsername == filter.CreatorUsername)
+}
+
+// tenderOrder собирает сравнение по полям сортировки. Поле не из белого списка отклоняется.
+func tenderOrder(fields []model.SortField) (func(a, b model.Tender) int, error) {
+	keys := make([]func(a, b model.Tender) int, 0, len(fields))
+	for _, f := range fields {
+		key, ok := tenderSortKeys[f.Field]
+		if !ok {
+			return nil, custom_errors.ErrUnprocessableEntity
+		}
+		if f.Desc {
+			asc := key
+			key = func(a, b model.Tender) int { return asc(b, a) }
+		}
+		keys = append(keys, key)
+	}
+	return func(a, b model.Tender) int {
+		for _, key := range keys {
+			if c := key(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}, nil
+}
+
+func (tR *TenderRepository) CreateTender(ctx context.Context, tender model.Tender) (model.Tender, error) {
+	var res model.Tender
+	err := tR.update(ctx, func() error {
+		if _, ok := tR.data.organizations[tender.OrganizationID]; !ok {
+			return custom_errors.ErrOrganizationNotFound
+		}
+		now := tR.now()
+		tender.ID = uuid.New()
+		tender.Version = 1
+		tender.CreatedAt = now
+		tender.UpdatedAt = now
+		tR.data.tenders[tender.ID] = tenderRow{Tender: tender}
+		tR.saveTenderVersion(tender.ID)
+
+		res = tender
+		return nil
+	})
+	return res, err
+}
+
+func (tR *TenderRepository) GetTender(
+	ctx context.Context,
+	user string,
+	p model.Page,
+) (model.List[model.Tender], error) {
+	tenders := make([]model.Tender, 0)
+	err := tR.view(ctx, func() error {
+		for _, t := range tR.data.tenders {
+			if t.CreatorUsername == user {
+				tenders = append(tenders, t.Tender)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return model.List[model.Tender]{}, err
+	}
+	return keysetPage(tenders, p), nil
+}
+
+func (tR *TenderRepository) UpdateTender(ctx context.Context, tender model.Tender) (model.Tender, error) {
+	var res model.Tender
+	err := tR.update(ctx, func() error {
+		current, err := tR.lockTenderVersion(tender.ID, tender.Version)
+		if err != nil {
+			return err
+		}
+		if tender.Title != "" {
+			current.Title = tender.Title
+		}
+		if tender.Description != "" {
+			current.Description = tender.Description
+		}
+		if tender.ServiceType != "" {
+			current.ServiceType = tender.ServiceType
+		}
+		if tender.Status != "" {
+			current.Status = tender.Status
+			current.ClosedBy = tender.ClosedBy
+		}
+		if tender.SubmissionDeadline != nil {
+			current.SubmissionDeadline = tender.SubmissionDeadline
+		}
+		res = tR.bumpTender(current)
+		return nil
+	})
+	return res, err
+}
+
+func (tR *TenderRepository) GetStatus(ctx context.Context, tenderId uuid.UUID, user string) (string, error) {
+	var status string
+	err := tR.view(ctx, func() error {
+		t, ok := tR.data.tenders[tenderId]
+		if !ok {
+			return custom_errors.ErrTenderNotFound
+		}
+		if !tR.tenderVisibleTo(t.Tender, user) {
+			return custom_errors.ErrAccessDenied
+		}
+		status = t.Status
+		return nil
+	})
+	return status, err
+}
+
+func (tR *TenderRepository) UpdateStatus(ctx context.Context, tender model.Tender) (model.Tender, error) {
+	var res model.Tender
+	err := tR.update(ctx, func() error {
+		current, err := tR.lockTenderVersion(tender.ID, tender.Version)
+		if err != nil {
+			return err
+		}
+		current.Status = tender.Status
+		current.ClosedBy = tender.ClosedBy
+		res = tR.bumpTender(current)
+		return nil
+	})
+	return res, err
+}
+
+// GetExpiredTenders возвращает до limit опубликованных тендеров, срок подачи предложений которых
+// истек к моменту now, начиная с самых просроченных.
+func (tR *TenderRepository) GetExpiredTenders(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	var expired []model.Tender
+	err := tR.view(ctx, func() error {
+		for _, t := range tR.data.tenders {
+			if t.Status == model.TenderStatusPublished && t.DeadlinePassed(now) {
+				expired = append(expired, t.Tender)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].SubmissionDeadline.Before(*expired[j].SubmissionDeadline)
+	})
+	ids := make([]uuid.UUID, 0, min(len(expired), limit))
+	for _, t := range page(expired, limit, 0) {
+		ids = append(ids, t.ID)
+	}
+	return ids, nil
+}
+
+func (tR *TenderRepository) RollbackTender(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	version, expectedVersion int,
+) (model.Tender, error) {
+	var res model.Tender
+	err := tR.update(ctx, func() error {
+		current, err := tR.lockTenderVersion(tenderId, expectedVersion)
+		if err != nil {
+			return err
+		}
+		i := slices.IndexFunc(tR.data.tenderVersions[tenderId], func(v model.Tender) bool {
+			return v.Version == version
+		})
+		if i < 0 {
+			return custom_errors.ErrVersionNotFound
+		}
+		// Откат считается новой правкой: параметры берутся из снимка, версия увеличивается
+		snapshot := tR.data.tenderVersions[tenderId][i]
+		current.Title = snapshot.Title
+		current.Description = snapshot.Description
+		current.ServiceType = snapshot.ServiceType
+		res = tR.bumpTender(current)
+		return nil
+	})
+	return res, err
+}
+
+func (tR *TenderRepository) GetTenderById(ctx context.Context, tenderId uuid.UUID) (model.Tender, error) {
+	var res model.Tender
+	err := tR.view(ctx, func() error {
+		t, ok := tR.data.tenders[tenderId]
+		if !ok {
+			return custom_errors.ErrTenderNotFound
+		}
+		res = t.Tender
+		return nil
+	})
+	return res, err
+}
+
+// LockTender только проверяет, что тендер существует: внутри WithinTx хранилище и так заблокировано целиком.
+func (tR *TenderRepository) LockTender(ctx context.Context, tenderId uuid.UUID) error {
+	_, err := tR.GetTenderById(ctx, tenderId)
+	return err
+}
+
+func (tR *TenderRepository) SettleTenderBids(ctx context.Context, tenderId uuid.UUID) error {
+	return tR.update(ctx, func() error {
+		tR.markBidsLost(tenderId, uuid.Nil)
+		return nil
+	})
+}
+
+// lockTenderVersion возвращает тендер, если его версия совпадает с ожидаемой. Нулевая версия не проверяется.
+func (s *Store) lockTenderVersion(tenderId uuid.UUID, expected int) (tenderRow, error) {
+	current, ok := s.data.tenders[tenderId]
+	if !ok {
+		return tenderRow{}, custom_errors.ErrTenderNotFound
+	}
+	if expected != 0 && current.Version != expected {
+		return tenderRow{}, custom_errors.ErrVersionConflict
+	}
+	return current, nil
+}
+
+// bumpTender сохраняет правку тендера: увеличивает версию и записывает снимок.
+func (s *Store) bumpTender(t tenderRow) model.Tender {
+	t.Version++
+	t.UpdatedAt = s.now()
+	s.data.tenders[t.ID] = t
+	s.saveTenderVersion(t.ID)
+	return t.Tender
+}
+
+func (s *Store) saveTenderVersion(tenderId uuid.UUID) {
+	snapshot := s.data.tenders[tenderId].Tender
+	snapshot.CreatedAt = s.now()
+	s.data.tenderVersions[tenderId] = append(s.data.tenderVersions[tenderId], snapshot)
+}
+
+// deleteTender удаляет тендер вместе с его версиями и предложениями.
+func (s *Store) deleteTender(tenderId uuid.UUID) {
+	delete(s.data.tenders, tenderId)
+	delete(s.data.tenderVersions, tenderId)
+	for id, b := range s.data.bids {
+		if b.TenderID == tenderId {
+			s.deleteBid(id)
+		}
+	}
+}
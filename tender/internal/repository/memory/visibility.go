@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"zadanie-6105/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// Правила видимости повторяют SQL-условия из repository/visibility.go.
+
+// permitted сообщает, дает ли роль пользователя в организации право permission.
+func (s *Store) permitted(username string, organizationId uuid.UUID, permission model.Permission) bool {
+	employee, ok := s.employeeByUsername(username)
+	if !ok {
+		return false
+	}
+	for _, r := range s.data.responsibles {
+		if r.OrganizationID == organizationId && r.UserID == employee.ID && r.Role.Allows(permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// tenderVisibleTo: опубликованные тендеры видны всем, остальные — только участникам организации
+// с правом просмотра тендеров.
+func (s *Store) tenderVisibleTo(tender model.Tender, username string) bool {
+	return tender.Status == model.TenderStatusPublished ||
+		s.permitted(username, tender.OrganizationID, model.PermissionTenderView)
+}
+
+// bidVisibleTo: предложение видят автор и участники его организации с правом просмотра предложений,
+// а опубликованное — еще и такие же участники организации тендера.
+func (s *Store) bidVisibleTo(bid model.Bids, tender model.Tender, username string) bool {
+	return bid.CreatorUsername == username ||
+		s.permitted(username, bid.OrganizationID, model.PermissionBidView) ||
+		(bid.Status == model.BidsStatusPublished &&
+			s.permitted(username, tender.OrganizationID, model.PermissionBidView))
+}
@@ -0,0 +1,443 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/pkg/postgres"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type OrganizationRepository struct {
+	*postgres.DB
+}
+
+func NewOrganizationRepository(db *postgres.DB) *OrganizationRepository {
+	return &OrganizationRepository{db}
+}
+
+func (oR *OrganizationRepository) CreateOrganization(
+	ctx context.Context,
+	organization model.Organization,
+	responsibleUsername string,
+) (model.Organization, error) {
+	path := "internal.repository.organization.CreateOrganization"
+
+	tx, err := oR.DB.Begin(ctx)
+	if err != nil {
+		return model.Organization{}, fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	sql := `INSERT INTO organization (name, description, type)
+					VALUES ($1, $2, $3)
+					RETURNING id, name, COALESCE(description, ''), type::text, created_at, updated_at`
+
+	var res model.Organization
+	err = tx.QueryRow(ctx, sql,
+		organization.Name,
+		organization.Description,
+		string(organization.Type)).Scan(&res.ID,
+		&res.Name,
+		&res.Description,
+		&res.Type,
+		&res.CreatedAt,
+		&res.UpdatedAt)
+	if err != nil {
+		return model.Organization{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+
+	// Создатель организации становится ее первым владельцем
+	err = addResponsible(ctx, tx, res.ID, responsibleUsername, model.OrganizationRoleOwner)
+	if err != nil {
+		return model.Organization{}, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.Organization{}, fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (oR *OrganizationRepository) GetOrganizations(
+	ctx context.Context,
+	limit, offset int,
+) ([]model.Organization, error) {
+	path := "internal.repository.organization.GetOrganizations"
+	sql := `SELECT id, name, COALESCE(description, ''), COALESCE(type::text, ''), created_at, updated_at
+					FROM organization
+					ORDER BY name, id
+					LIMIT $1 OFFSET $2`
+
+	rows, err := oR.DB.Querier(ctx).Query(ctx, sql, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	defer rows.Close()
+
+	res := make([]model.Organization, 0)
+	for rows.Next() {
+		var organization model.Organization
+		err = rows.Scan(&organization.ID,
+			&organization.Name,
+			&organization.Description,
+			&organization.Type,
+			&organization.CreatedAt,
+			&organization.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+		}
+		res = append(res, organization)
+	}
+	return res, nil
+}
+
+func (oR *OrganizationRepository) GetOrganization(
+	ctx context.Context,
+	organizationId uuid.UUID,
+) (model.Organization, error) {
+	path := "internal.repository.organization.GetOrganization"
+	sql := `SELECT id, name, COALESCE(description, ''), COALESCE(type::text, ''), created_at, updated_at
+					FROM organization
+					WHERE id = $1`
+
+	var res model.Organization
+	err := oR.DB.Querier(ctx).QueryRow(ctx, sql, organizationId).Scan(&res.ID,
+		&res.Name,
+		&res.Description,
+		&res.Type,
+		&res.CreatedAt,
+		&res.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Organization{}, custom_errors.ErrOrganizationNotFound
+		}
+		return model.Organization{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (oR *OrganizationRepository) UpdateOrganization(
+	ctx context.Context,
+	organization model.Organization,
+) (model.Organization, error) {
+	path := "internal.repository.organization.UpdateOrganization"
+
+	// Пустые значения не меняют соответствующие поля
+	sql := `UPDATE organization
+					SET name = COALESCE(NULLIF($2, ''), name),
+					    description = COALESCE(NULLIF($3, ''), description),
+					    type = COALESCE(NULLIF($4, '')::organization_type, type),
+					    updated_at = NOW()
+					WHERE id = $1
+					RETURNING id, name, COALESCE(description, ''), COALESCE(type::text, ''), created_at, updated_at`
+
+	var res model.Organization
+	err := oR.DB.Querier(ctx).QueryRow(ctx, sql,
+		organization.ID,
+		organization.Name,
+		organization.Description,
+		string(organization.Type)).Scan(&res.ID,
+		&res.Name,
+		&res.Description,
+		&res.Type,
+		&res.CreatedAt,
+		&res.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Organization{}, custom_errors.ErrOrganizationNotFound
+		}
+		return model.Organization{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (oR *OrganizationRepository) DeleteOrganization(ctx context.Context, organizationId uuid.UUID) error {
+	path := "internal.repository.organization.DeleteOrganization"
+	sql := `DELETE FROM organization WHERE id = $1`
+
+	tag, err := oR.DB.Querier(ctx).Exec(ctx, sql, organizationId)
+	if err != nil {
+		return fmt.Errorf(path+".Exec, error: {%s}", err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return custom_errors.ErrOrganizationNotFound
+	}
+	return nil
+}
+
+// GetMemberRole возвращает роль сотрудника в организации или пустую роль, если он в нее не входит.
+func (oR *OrganizationRepository) GetMemberRole(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	username string,
+) (model.OrganizationRole, error) {
+	path := "internal.repository.organization.GetMemberRole"
+	sql := `SELECT o.id IS NOT NULL, COALESCE(r.role, '')
+	        FROM employee e
+	        LEFT JOIN organization o ON o.id = $1
+	        LEFT JOIN organization_responsible r ON r.organization_id = o.id AND r.user_id = e.id
+	        WHERE e.username = $2`
+
+	var (
+		organizationExists bool
+		role               model.OrganizationRole
+	)
+	err := oR.DB.Querier(ctx).QueryRow(ctx, sql, organizationId, username).Scan(&organizationExists, &role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", custom_errors.ErrUserNotFound
+		}
+		return "", fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	if !organizationExists {
+		return "", custom_errors.ErrOrganizationNotFound
+	}
+	return role, nil
+}
+
+func (oR *OrganizationRepository) GetEmployeeRoles(
+	ctx context.Context,
+	username string,
+) ([]model.OrganizationResponsible, error) {
+	path := "internal.repository.organization.GetEmployeeRoles"
+	sql := `SELECT r.id, r.organization_id, r.user_id, r.role
+					FROM organization_responsible r
+					JOIN employee e ON e.id = r.user_id
+					WHERE e.username = $1
+					ORDER BY r.organization_id`
+
+	rows, err := oR.DB.Querier(ctx).Query(ctx, sql, username)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	defer rows.Close()
+
+	res := make([]model.OrganizationResponsible, 0)
+	for rows.Next() {
+		var responsible model.OrganizationResponsible
+		err = rows.Scan(&responsible.ID,
+			&responsible.OrganizationID,
+			&responsible.UserID,
+			&responsible.Role)
+		if err != nil {
+			return nil, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+		}
+		res = append(res, responsible)
+	}
+	return res, nil
+}
+
+func (oR *OrganizationRepository) GetResponsibles(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	limit, offset int,
+) ([]model.OrganizationResponsible, error) {
+	path := "internal.repository.organization.GetResponsibles"
+	sql := `SELECT r.id,
+	               r.organization_id,
+	               r.role,
+	               e.id,
+	               e.username,
+	               COALESCE(e.first_name, ''),
+	               COALESCE(e.last_name, ''),
+	               e.created_at,
+	               e.updated_at
+					FROM organization_responsible r
+					JOIN employee e ON e.id = r.user_id
+					WHERE r.organization_id = $1
+					ORDER BY e.username
+					LIMIT $2 OFFSET $3`
+
+	rows, err := oR.DB.Querier(ctx).Query(ctx, sql, organizationId, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	defer rows.Close()
+
+	res := make([]model.OrganizationResponsible, 0)
+	for rows.Next() {
+		var responsible model.OrganizationResponsible
+		err = rows.Scan(&responsible.ID,
+			&responsible.OrganizationID,
+			&responsible.Role,
+			&responsible.Employee.ID,
+			&responsible.Employee.Username,
+			&responsible.Employee.FirstName,
+			&responsible.Employee.LastName,
+			&responsible.Employee.CreatedAt,
+			&responsible.Employee.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+		}
+		responsible.UserID = responsible.Employee.ID
+		res = append(res, responsible)
+	}
+	return res, nil
+}
+
+func (oR *OrganizationRepository) AddResponsible(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	username string,
+	role model.OrganizationRole,
+) error {
+	path := "internal.repository.organization.AddResponsible"
+
+	tx, err := oR.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = addResponsible(ctx, tx, organizationId, username, role)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return nil
+}
+
+func (oR *OrganizationRepository) UpdateResponsibleRole(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	username string,
+	role model.OrganizationRole,
+) error {
+	path := "internal.repository.organization.UpdateResponsibleRole"
+
+	tx, err := oR.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	owners, err := lockOwners(ctx, tx, organizationId)
+	if err != nil {
+		return err
+	}
+
+	currentSQL := `SELECT r.id, r.role
+	               FROM organization_responsible r
+	               JOIN employee e ON e.id = r.user_id
+	               WHERE r.organization_id = $1 AND e.username = $2`
+	var (
+		responsibleId uuid.UUID
+		currentRole   model.OrganizationRole
+	)
+	err = tx.QueryRow(ctx, currentSQL, organizationId, username).Scan(&responsibleId, &currentRole)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return custom_errors.ErrResponsibleNotFound
+		}
+		return fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	if currentRole == model.OrganizationRoleOwner && role != model.OrganizationRoleOwner && owners <= 1 {
+		return custom_errors.ErrLastOwner
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE organization_responsible SET role = $2 WHERE id = $1`, responsibleId, string(role))
+	if err != nil {
+		return fmt.Errorf(path+".Exec, error: {%s}", err.Error())
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return nil
+}
+
+func (oR *OrganizationRepository) RemoveResponsible(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	username string,
+) error {
+	path := "internal.repository.organization.RemoveResponsible"
+
+	tx, err := oR.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	owners, err := lockOwners(ctx, tx, organizationId)
+	if err != nil {
+		return err
+	}
+
+	deleteSQL := `DELETE FROM organization_responsible
+	              WHERE organization_id = $1 AND user_id = (SELECT id FROM employee WHERE username = $2)
+	              RETURNING role`
+	var role model.OrganizationRole
+	err = tx.QueryRow(ctx, deleteSQL, organizationId, username).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return custom_errors.ErrResponsibleNotFound
+		}
+		return fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	if role == model.OrganizationRoleOwner && owners <= 1 {
+		return custom_errors.ErrLastOwner
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return nil
+}
+
+// lockOwners блокирует участников организации и возвращает число владельцев,
+// чтобы параллельные изменения не оставили организацию без владельца.
+func lockOwners(ctx context.Context, tx pgx.Tx, organizationId uuid.UUID) (int, error) {
+	path := "internal.repository.organization.lockOwners"
+	sql := `SELECT COUNT(*) FILTER (WHERE role = $2)
+	        FROM (SELECT role FROM organization_responsible WHERE organization_id = $1 FOR UPDATE) r`
+
+	var owners int
+	err := tx.QueryRow(ctx, sql, organizationId, string(model.OrganizationRoleOwner)).Scan(&owners)
+	if err != nil {
+		return 0, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return owners, nil
+}
+
+func addResponsible(
+	ctx context.Context,
+	tx pgx.Tx,
+	organizationId uuid.UUID,
+	username string,
+	role model.OrganizationRole,
+) error {
+	path := "internal.repository.organization.addResponsible"
+	sql := `INSERT INTO organization_responsible (organization_id, user_id, role)
+	        SELECT $1, id, $3 FROM employee WHERE username = $2`
+
+	tag, err := tx.Exec(ctx, sql, organizationId, username, string(role))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok {
+			switch pgErr.Code {
+			case "23505":
+				return custom_errors.ErrResponsibleAlreadyExists
+			case "23503":
+				return custom_errors.ErrOrganizationNotFound
+			}
+		}
+		return fmt.Errorf(path+".Exec, error: {%s}", err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return custom_errors.ErrEmployeeNotFound
+	}
+	return nil
+}
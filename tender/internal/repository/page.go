@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/pkg/postgres"
+)
+
+// keysetPage возвращает хвост запроса для страницы: условие курсора, сортировку по (created_at, id)
+// от новых к старым и LIMIT. alias — псевдоним таблицы, args — уже собранные аргументы запроса.
+// OFFSET применяется, только если курсора нет.
+func keysetPage(alias string, page model.Page, args []interface{}) (string, []interface{}) {
+	var sql string
+	if page.After != nil {
+		args = append(args, page.After.CreatedAt, page.After.ID)
+		sql += fmt.Sprintf(` AND (%[1]s.created_at, %[1]s.id) < ($%[2]d, $%[3]d)`, alias, len(args)-1, len(args))
+	}
+	sql += fmt.Sprintf(` ORDER BY %[1]s.created_at DESC, %[1]s.id DESC`, alias)
+
+	args = append(args, page.Limit)
+	sql += fmt.Sprintf(` LIMIT $%d`, len(args))
+	if page.After == nil && page.Offset > 0 {
+		args = append(args, page.Offset)
+		sql += fmt.Sprintf(` OFFSET $%d`, len(args))
+	}
+	return sql, args
+}
+
+// withTotal дополняет страницу общим числом записей, если его запросили. from — часть запроса
+// от FROM до конца условия WHERE, args — ее аргументы без аргументов страницы.
+func withTotal[T any](
+	ctx context.Context,
+	q postgres.Querier,
+	list model.List[T],
+	page model.Page,
+	from string,
+	args []interface{},
+) (model.List[T], error) {
+	path := "internal.repository.page.withTotal"
+
+	if !page.WithTotal {
+		return list, nil
+	}
+	var total int
+	err := q.QueryRow(ctx, `SELECT COUNT(*) `+from, args...).Scan(&total)
+	if err != nil {
+		return model.List[T]{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	list.Total = &total
+	return list, nil
+}
+
+// sortedPage возвращает хвост запроса для страницы с сортировкой по полям клиента. Курсор хранит только
+// (created_at, id), поэтому такие страницы листаются через OFFSET, а (created_at, id) остается последним
+// ключом сортировки, чтобы порядок был однозначным.
+func sortedPage(alias string, page model.Page, q *queryBuilder) (string, []interface{}) {
+	sql := q.orderSQL() + fmt.Sprintf(`, %[1]s.created_at DESC, %[1]s.id DESC`, alias)
+	args := append(q.args[:len(q.args):len(q.args)], page.Limit, page.Offset)
+	sql += fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+	return sql, args
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/pkg/postgres"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const publicationColumns = `tender_id, publish_at, status, created_by, error, updated_at`
+
+type PublicationRepository struct {
+	*postgres.DB
+}
+
+func NewPublicationRepository(db *postgres.DB) *PublicationRepository {
+	return &PublicationRepository{db}
+}
+
+func scanPublication(row pgx.Row) (model.TenderPublication, error) {
+	var res model.TenderPublication
+	err := row.Scan(&res.TenderID,
+		&res.PublishAt,
+		&res.Status,
+		&res.CreatedBy,
+		&res.Error,
+		&res.UpdatedAt)
+	return res, err
+}
+
+// SavePublication планирует публикацию тендера или переносит уже запланированную. Строка тендера
+// переиспользуется, поэтому после отмены или срабатывания публикацию можно запланировать заново.
+func (pR *PublicationRepository) SavePublication(
+	ctx context.Context,
+	publication model.TenderPublication,
+) (model.TenderPublication, error) {
+	path := "internal.repository.publication.SavePublication"
+
+	sql := `INSERT INTO tender_publication (tender_id, publish_at, status, created_by)
+	        VALUES ($1, $2, $3, $4)
+	        ON CONFLICT (tender_id) DO UPDATE
+	        SET publish_at = EXCLUDED.publish_at, status = EXCLUDED.status, created_by = EXCLUDED.created_by,
+	            error = '', updated_at = NOW()
+	        RETURNING ` + publicationColumns
+
+	res, err := scanPublication(pR.DB.Querier(ctx).QueryRow(ctx, sql,
+		publication.TenderID,
+		publication.PublishAt,
+		model.PublicationPending,
+		publication.CreatedBy))
+	if err != nil {
+		return model.TenderPublication{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (pR *PublicationRepository) GetPublication(
+	ctx context.Context,
+	tenderId uuid.UUID,
+) (model.TenderPublication, error) {
+	path := "internal.repository.publication.GetPublication"
+
+	sql := `SELECT ` + publicationColumns + ` FROM tender_publication WHERE tender_id = $1`
+
+	res, err := scanPublication(pR.DB.Querier(ctx).QueryRow(ctx, sql, tenderId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.TenderPublication{}, custom_errors.ErrPublicationNotFound
+	}
+	if err != nil {
+		return model.TenderPublication{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+// SetPublicationStatus записывает итог публикации; errorCode заполняется только для model.PublicationFailed.
+func (pR *PublicationRepository) SetPublicationStatus(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	status, errorCode string,
+) (model.TenderPublication, error) {
+	path := "internal.repository.publication.SetPublicationStatus"
+
+	sql := `UPDATE tender_publication SET status = $2, error = $3, updated_at = NOW()
+	        WHERE tender_id = $1
+	        RETURNING ` + publicationColumns
+
+	res, err := scanPublication(pR.DB.Querier(ctx).QueryRow(ctx, sql, tenderId, status, errorCode))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.TenderPublication{}, custom_errors.ErrPublicationNotFound
+	}
+	if err != nil {
+		return model.TenderPublication{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+// GetDuePublications возвращает до limit тендеров, время публикации которых наступило к моменту now,
+// начиная с самых ранних.
+func (pR *PublicationRepository) GetDuePublications(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]uuid.UUID, error) {
+	path := "internal.repository.publication.GetDuePublications"
+	sql := `SELECT tender_id
+	        FROM tender_publication
+	        WHERE status = $1 AND publish_at <= $2
+	        ORDER BY publish_at
+	        LIMIT $3`
+
+	rows, err := pR.DB.Querier(ctx).Query(ctx, sql, model.PublicationPending, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf(path+".CollectRows, error: {%s}", err.Error())
+	}
+	return ids, nil
+}
@@ -2,38 +2,123 @@ package repository
 
 import (
 	"context"
+	"time"
 	"zadanie-6105/internal/model"
 	"zadanie-6105/pkg/postgres"
 
 	"github.com/google/uuid"
 )
 
+// Transactor объединяет вызовы репозиториев в одну транзакцию: репозитории, получившие контекст
+// из fn, работают внутри нее. Ошибка fn откатывает все изменения.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
 type ITender interface {
-	GetTenders(ctx context.Context, limit int, offset int, serviceTypesArr []string) ([]model.Tender, error)
+	GetTenders(
+		ctx context.Context, user string, page model.Page, filter model.TenderFilter,
+	) (model.List[model.Tender], error)
 	CreateTender(ctx context.Context, tender model.Tender) (model.Tender, error)
-	GetTender(ctx context.Context, user string, limit int, offset int) ([]model.Tender, error)
+	GetTender(ctx context.Context, user string, page model.Page) (model.List[model.Tender], error)
 	UpdateTender(ctx context.Context, tender model.Tender) (model.Tender, error)
-	GetStatus(ctx context.Context, tenderId uuid.UUID) (string, error)
+	GetStatus(ctx context.Context, tenderId uuid.UUID, user string) (string, error)
 	UpdateStatus(ctx context.Context, tender model.Tender) (model.Tender, error)
-	IsUserResponsibleForOrganization(ctx context.Context, tender model.Tender) (bool, error)
+	RollbackTender(ctx context.Context, tenderId uuid.UUID, version, expectedVersion int) (model.Tender, error)
+	GetTenderById(ctx context.Context, tenderId uuid.UUID) (model.Tender, error)
+	LockTender(ctx context.Context, tenderId uuid.UUID) error
+	GetExpiredTenders(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+	SettleTenderBids(ctx context.Context, tenderId uuid.UUID) error
 }
 type IBids interface {
 	CreateBids(ctx context.Context, bids *model.Bids) (model.Bids, error)
-	GetBids(ctx context.Context, user string, limit, offset int) ([]model.Bids, error)
-	GetBidsByTenderId(ctx context.Context, user string, tenderId uuid.UUID, limit, offset int) ([]model.Bids, error)
+	GetBids(ctx context.Context, user string, page model.Page) (model.List[model.Bids], error)
+	GetBidsByTenderId(
+		ctx context.Context, user string, tenderId uuid.UUID, page model.Page,
+	) (model.List[model.Bids], error)
 	UpdateBids(ctx context.Context, bids *model.Bids) (model.Bids, error)
-	IsUserAuthorizedToCreateBid(ctx context.Context, bids *model.Bids) (bool, error)
 	IsTenderValid(ctx context.Context, tenderID uuid.UUID) (bool, error)
 	GetBidStatus(ctx context.Context, bidId uuid.UUID, user string) (string, error)
-	CheckUserExists(ctx context.Context, username string) (bool, error)
 	UpdateBidsStatus(ctx context.Context, bids model.Bids) (model.Bids, error)
 	UpdateBidsDecision(ctx context.Context, bidId uuid.UUID, decision, username string) (model.Bids, error)
+	GetBidVersions(ctx context.Context, bidId uuid.UUID, limit, offset int) ([]model.Bids, error)
+	GetBidVersion(ctx context.Context, bidId uuid.UUID, version int) (model.Bids, error)
+	RollbackBids(ctx context.Context, bidId uuid.UUID, version, expectedVersion int) (model.Bids, error)
+	GetBidById(ctx context.Context, bidId uuid.UUID) (model.Bids, error)
+	LockBid(ctx context.Context, bidId uuid.UUID) error
+	GetBidDecisions(ctx context.Context, bidId uuid.UUID) ([]model.BidDecision, error)
+}
+type IFeedback interface {
+	CreateBidFeedback(ctx context.Context, feedback model.BidFeedback) (model.BidFeedback, error)
+	HasAuthorBidOnTender(ctx context.Context, tenderId uuid.UUID, authorUsername string) (bool, error)
+	GetAuthorReviews(ctx context.Context, authorUsername string, limit, offset int) ([]model.BidFeedback, error)
+}
+type IOrganization interface {
+	CreateOrganization(
+		ctx context.Context,
+		organization model.Organization,
+		responsibleUsername string,
+	) (model.Organization, error)
+	GetOrganizations(ctx context.Context, limit, offset int) ([]model.Organization, error)
+	GetOrganization(ctx context.Context, organizationId uuid.UUID) (model.Organization, error)
+	UpdateOrganization(ctx context.Context, organization model.Organization) (model.Organization, error)
+	DeleteOrganization(ctx context.Context, organizationId uuid.UUID) error
+	GetMemberRole(ctx context.Context, organizationId uuid.UUID, username string) (model.OrganizationRole, error)
+	GetEmployeeRoles(ctx context.Context, username string) ([]model.OrganizationResponsible, error)
+	GetResponsibles(
+		ctx context.Context,
+		organizationId uuid.UUID,
+		limit, offset int,
+	) ([]model.OrganizationResponsible, error)
+	AddResponsible(ctx context.Context, organizationId uuid.UUID, username string, role model.OrganizationRole) error
+	UpdateResponsibleRole(
+		ctx context.Context,
+		organizationId uuid.UUID,
+		username string,
+		role model.OrganizationRole,
+	) error
+	RemoveResponsible(ctx context.Context, organizationId uuid.UUID, username string) error
+}
+type IEmployee interface {
+	CreateEmployee(ctx context.Context, employee model.Employee) (model.Employee, error)
+	GetEmployees(ctx context.Context, limit, offset int) ([]model.Employee, error)
+	GetEmployee(ctx context.Context, username string) (model.Employee, error)
+	GetEmployeeCredentials(ctx context.Context, username string) (model.Employee, error)
+	UpdateEmployee(ctx context.Context, employee model.Employee) (model.Employee, error)
+	DeleteEmployee(ctx context.Context, username string) error
+}
+type ISearch interface {
+	SearchTenders(ctx context.Context, user string, search model.TenderSearch) ([]model.TenderSearchResult, error)
+}
+type IPublication interface {
+	SavePublication(ctx context.Context, publication model.TenderPublication) (model.TenderPublication, error)
+	GetPublication(ctx context.Context, tenderId uuid.UUID) (model.TenderPublication, error)
+	SetPublicationStatus(
+		ctx context.Context,
+		tenderId uuid.UUID,
+		status, errorCode string,
+	) (model.TenderPublication, error)
+	GetDuePublications(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
 }
 type Repositories struct {
+	Transactor
 	ITender
 	IBids
+	IFeedback
+	IOrganization
+	IEmployee
+	ISearch
+	IPublication
 }
 
 func NewRepositories(db *postgres.DB) *Repositories {
-	return &Repositories{NewTenderRepository(db), NewBidsRepository(db)}
+	return &Repositories{
+		db,
+		NewTenderRepository(db),
+		NewBidsRepository(db),
+		NewFeedbackRepository(db),
+		NewOrganizationRepository(db),
+		NewEmployeeRepository(db),
+		NewSearchRepository(db),
+		NewPublicationRepository(db),
+	}
 }
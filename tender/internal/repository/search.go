@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/pkg/postgres"
+)
+
+// headlineOptions — параметры ts_headline: до двух фрагментов по 10–25 слов, совпадения отмечаются
+// служебными символами model.HighlightStart и model.HighlightStop.
+var headlineOptions = fmt.Sprintf(
+	`StartSel="%s", StopSel="%s", MinWords=10, MaxWords=25, MaxFragments=2, FragmentDelimiter=" … "`,
+	model.HighlightStart, model.HighlightStop)
+
+type SearchRepository struct {
+	*postgres.DB
+}
+
+func NewSearchRepository(db *postgres.DB) *SearchRepository {
+	return &SearchRepository{db}
+}
+
+// SearchTenders ищет по колонке tender.search. Запрос разбирается обеими конфигурациями, и тендер подходит,
+// если совпал хотя бы один разбор: русский приводит к основе кириллицу, английский отбрасывает английские
+// стоп-слова. Сниппет строится русской конфигурацией, она приводит к основе и латиницу.
+func (sR *SearchRepository) SearchTenders(
+	ctx context.Context,
+	user string,
+	search model.TenderSearch,
+) ([]model.TenderSearchResult, error) {
+	path := "internal.repository.search.SearchTenders"
+
+	args := []interface{}{user, search.Query, headlineOptions, search.Limit, search.Offset}
+	sql := `WITH q AS (
+	            SELECT websearch_to_tsquery('russian', $2) || websearch_to_tsquery('english', $2) AS query
+	        )
+	        SELECT t.id, t.organization_id, t.title, t.description, t.service_type, t.status, t.version,
+	               t.created_at, t.creator_username, t.submission_deadline, t.closed_by,
+	               ts_rank_cd(t.search, q.query) AS rank,
+	               ts_headline('russian', t.title || E'\n' || coalesce(t.description, ''), q.query, $3)
+	        FROM tender t, q
+	        WHERE t.search @@ q.query AND ` + tenderVisibleTo("t", 1)
+	if len(search.ServiceTypes) > 0 {
+		args = append(args, search.ServiceTypes)
+		sql += ` AND t.service_type = ANY($6)`
+	}
+	sql += ` ORDER BY rank DESC, t.created_at DESC, t.id DESC LIMIT $4 OFFSET $5`
+
+	rows, err := sR.DB.Querier(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	defer rows.Close()
+
+	res := make([]model.TenderSearchResult, 0)
+	for rows.Next() {
+		var r model.TenderSearchResult
+		err = rows.Scan(&r.Tender.ID,
+			&r.Tender.OrganizationID,
+			&r.Tender.Title,
+			&r.Tender.Description,
+			&r.Tender.ServiceType,
+			&r.Tender.Status,
+			&r.Tender.Version,
+			&r.Tender.CreatedAt,
+			&r.Tender.CreatorUsername,
+			&r.Tender.SubmissionDeadline,
+			&r.Tender.ClosedBy,
+			&r.Rank,
+			&r.Snippet,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+		}
+		res = append(res, r)
+	}
+	return res, rows.Err()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 	custom_errors "zadanie-6105/internal/custom-errors"
 	"zadanie-6105/internal/model"
 	"zadanie-6105/pkg/postgres"
@@ -21,50 +22,96 @@ func NewTenderRepository(db *postgres.DB) *TenderRepository {
 	return &TenderRepository{db}
 }
 
+// tenderSortColumns — поля, по которым клиент может сортировать список тендеров.
+var tenderSortColumns = columns{
+	"name":        "t.title",
+	"createdAt":   "t.created_at",
+	"version":     "t.version",
+	"status":      "t.status",
+	"serviceType": "t.service_type",
+}
+
 func (tR *TenderRepository) GetTenders(
 	ctx context.Context,
-	limit int,
-	offset int,
-	serviceTypesArr []string,
-) ([]model.Tender, error) {
+	user string,
+	page model.Page,
+	filter model.TenderFilter,
+) (model.List[model.Tender], error) {
 	path := "internal.repository.tender.GetTenders"
-	sql := `SELECT id, title, description, service_type, status, version, created_at
-	        FROM tender`
 
-	args := []interface{}{limit, offset}
-	if len(serviceTypesArr) > 0 {
-		sql += ` WHERE service_type = ANY($3)`
-		args = append(args, serviceTypesArr)
+	query := &queryBuilder{}
+	query.where(tenderVisibleTo("t", query.arg(user)))
+	if len(filter.ServiceTypes) > 0 {
+		query.where("t.service_type = ANY(?)", filter.ServiceTypes)
+	}
+	if len(filter.Statuses) > 0 {
+		query.where("t.status = ANY(?)", filter.Statuses)
+	}
+	if filter.OrganizationID != uuid.Nil {
+		query.where("t.organization_id = ?", filter.OrganizationID)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		query.where("t.created_at >= ?", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		query.where("t.created_at < ?", filter.CreatedTo)
+	}
+	if filter.Version != 0 {
+		query.where("t.version = ?", filter.Version)
+	}
+	if filter.CreatorUsername != "" {
+		query.where("t.creator_username = ?", filter.CreatorUsername)
+	}
+	err := query.orderBy(tenderSortColumns, filter.Sort)
+	if err != nil {
+		return model.List[model.Tender]{}, err
 	}
 
-	sql += ` ORDER BY created_at DESC LIMIT $1 OFFSET $2`
+	from := `FROM tender t` + query.whereSQL()
+	var pageSQL string
+	var pageArgs []interface{}
+	if len(filter.Sort) > 0 {
+		pageSQL, pageArgs = sortedPage("t", page, query)
+	} else {
+		pageSQL, pageArgs = keysetPage("t", page, query.args)
+	}
+	sql := `SELECT t.id, t.organization_id, t.title, t.description, t.service_type, t.status, t.version, t.created_at,
+	               t.creator_username, t.submission_deadline, t.closed_by ` + from + pageSQL
 
-	rows, err := tR.DB.Pool.Query(ctx, sql, args...)
+	q := tR.DB.Querier(ctx)
+	rows, err := q.Query(ctx, sql, pageArgs...)
 	if err != nil {
-		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+		return model.List[model.Tender]{}, fmt.Errorf(path+".Query, error: {%s}", err.Error())
 	}
 	defer rows.Close()
 	tenders := make([]model.Tender, 0)
 	for rows.Next() {
 		var tender model.Tender
 		err = rows.Scan(&tender.ID,
+			&tender.OrganizationID,
 			&tender.Title,
 			&tender.Description,
 			&tender.ServiceType,
 			&tender.Status,
 			&tender.Version,
 			&tender.CreatedAt,
+			&tender.CreatorUsername,
+			&tender.SubmissionDeadline,
+			&tender.ClosedBy,
 		)
 		if err != nil {
-			return []model.Tender{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+			return model.List[model.Tender]{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
 		}
 		tenders = append(tenders, tender)
 	}
-	if len(tenders) == 0 {
-		return nil, custom_errors.ErrTenderNotFound
-	}
+	rows.Close()
 
-	return tenders, nil
+	list := model.NewList(tenders, page)
+	if len(filter.Sort) > 0 {
+		// Курсор не описывает позицию при сортировке клиента
+		list.Next = nil
+	}
+	return withTotal(ctx, q, list, page, from, query.args)
 }
 
 func (tR *TenderRepository) CreateTender(ctx context.Context, tender model.Tender) (model.Tender, error) {
@@ -77,25 +124,38 @@ func (tR *TenderRepository) CreateTender(ctx context.Context, tender model.Tende
      description,
      service_type,
      status,
-		 creator_username) VALUES ($1, $2, $3, $4, $5, $6)
-		 RETURNING id, title, description, service_type, version, status, created_at
+		 creator_username,
+		 submission_deadline) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 RETURNING id, organization_id, title, description, service_type, version, status, created_at, creator_username,
+		           submission_deadline, closed_by
 		 `
+	tx, err := tR.DB.Begin(ctx)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
 	var res model.Tender
-	err := tR.DB.Pool.QueryRow(ctx, sql,
+	err = tx.QueryRow(ctx, sql,
 		tender.OrganizationID,
 		tender.Title,
 		tender.Description,
 		tender.ServiceType,
 		tender.Status,
 		tender.CreatorUsername,
+		tender.SubmissionDeadline,
 	).Scan(
 		&res.ID,
+		&res.OrganizationID,
 		&res.Title,
 		&res.Description,
 		&res.ServiceType,
 		&res.Version,
 		&res.Status,
-		&res.CreatedAt)
+		&res.CreatedAt,
+		&res.CreatorUsername,
+		&res.SubmissionDeadline,
+		&res.ClosedBy)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if ok := errors.As(err, &pgErr); ok {
@@ -106,31 +166,45 @@ func (tR *TenderRepository) CreateTender(ctx context.Context, tender model.Tende
 		return model.Tender{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
 	}
 
-	return res, nil
-}
-
-func (tR *TenderRepository) IsUserResponsibleForOrganization(ctx context.Context, tender model.Tender) (bool, error) {
-	var count int
-	query := `
-        SELECT COUNT(*)
-        FROM organization_responsible
-        WHERE user_id = (SELECT id FROM employee WHERE username = $1)
-        AND organization_id = $2
-    `
-	err := tR.DB.Pool.QueryRow(ctx, query, tender.CreatorUsername, tender.OrganizationID).Scan(&count)
+	err = saveTenderVersion(ctx, tx, res.ID)
 	if err != nil {
-		return false, err
+		return model.Tender{}, fmt.Errorf(path+".saveTenderVersion, error: {%s}", err.Error())
 	}
-
-	return count > 0, nil
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return res, nil
 }
 
-func (tR *TenderRepository) GetTender(ctx context.Context, user string, limit int, offset int) ([]model.Tender, error) {
+func (tR *TenderRepository) GetTender(
+	ctx context.Context,
+	user string,
+	page model.Page,
+) (model.List[model.Tender], error) {
 	path := "internal.repository.tender.GetTender"
-	sql := `SELECT * FROM tender WHERE creator_username = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`
-	rows, err := tR.DB.Pool.Query(ctx, sql, user, limit, offset)
+
+	from := `FROM tender t WHERE t.creator_username = $1`
+	args := []interface{}{user}
+	pageSQL, pageArgs := keysetPage("t", page, args)
+	sql := `SELECT t.id,
+	               t.organization_id,
+	               t.title,
+	               t.description,
+	               t.service_type,
+	               t.status,
+	               t.version,
+	               t.created_at,
+	               t.updated_at,
+	               t.creator_username,
+	               t.submission_deadline,
+	               t.closed_by
+					` + from + pageSQL
+
+	q := tR.DB.Querier(ctx)
+	rows, err := q.Query(ctx, sql, pageArgs...)
 	if err != nil {
-		return []model.Tender{}, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+		return model.List[model.Tender]{}, fmt.Errorf(path+".Query, error: {%s}", err.Error())
 	}
 
 	defer rows.Close()
@@ -147,79 +221,98 @@ func (tR *TenderRepository) GetTender(ctx context.Context, user string, limit in
 			&tender.CreatedAt,
 			&tender.UpdatedAt,
 			&tender.CreatorUsername,
+			&tender.SubmissionDeadline,
+			&tender.ClosedBy,
 		)
 		if err != nil {
-			return []model.Tender{}, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+			return model.List[model.Tender]{}, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
 		}
 		tenders = append(tenders, tender)
 	}
-	if len(tenders) == 0 {
-		return []model.Tender{}, custom_errors.ErrTenderNotFound
-	}
-	return tenders, nil
+	rows.Close()
+
+	return withTotal(ctx, q, model.NewList(tenders, page), page, from, args)
 }
 
 func (tR *TenderRepository) UpdateTender(ctx context.Context, tender model.Tender) (model.Tender, error) {
 	path := "internal.repository.tender.UpdateTender"
-	sql := `SELECT creator_username FROM tender WHERE id = $1`
-	var creatorUsername string
-	err := tR.DB.Pool.QueryRow(ctx, sql, tender.ID).Scan(&creatorUsername)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return model.Tender{}, custom_errors.ErrUserNotFound
-		}
-		return model.Tender{}, fmt.Errorf(path+".QueryRow (creator check), error: {%s}", err.Error())
-	}
-	if creatorUsername != tender.CreatorUsername {
-		return model.Tender{}, custom_errors.ErrAccessDenied
-	}
-	query := "UPDATE tender SET updated_at = NOW(), version = version + 1, "
-	params := []interface{}{}
-	paramIndex := 1
-
+	update := &queryBuilder{sets: []string{"updated_at = NOW()", "version = version + 1"}}
 	if tender.Title != "" {
-		query += fmt.Sprintf("title = $%d, ", paramIndex)
-		params = append(params, tender.Title)
-		paramIndex++
+		update.set("title", tender.Title)
 	}
 	if tender.Description != "" {
-		query += fmt.Sprintf("description = $%d, ", paramIndex)
-		params = append(params, tender.Description)
-		paramIndex++
+		update.set("description", tender.Description)
+	}
+	if tender.ServiceType != "" {
+		update.set("service_type", tender.ServiceType)
 	}
 	if tender.Status != "" {
-		query += fmt.Sprintf("status = $%d, ", paramIndex)
-		params = append(params, tender.Status)
-		paramIndex++
+		// closed_by меняется вместе со статусом: при выходе из Closed он очищается
+		update.set("status", tender.Status)
+		update.set("closed_by", tender.ClosedBy)
+	}
+	if tender.SubmissionDeadline != nil {
+		update.set("submission_deadline", *tender.SubmissionDeadline)
 	}
+	update.where("id = ?", tender.ID)
 
-	query = query[:len(query)-2] // Убираем последнюю запятую
-	query += fmt.Sprintf(" WHERE id = $%d ", paramIndex)
-	params = append(params, tender.ID)
+	query := `UPDATE tender SET ` + update.setSQL() + update.whereSQL() + `
+	          RETURNING id, organization_id, title, description, service_type, status, version, created_at,
+	          creator_username, submission_deadline, closed_by`
 
-	query += "RETURNING id, title, description, service_type, status, version, created_at"
+	tx, err := tR.DB.Begin(ctx)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = lockTenderVersion(ctx, tx, tender.ID, tender.Version)
+	if err != nil {
+		return model.Tender{}, err
+	}
 
 	var res model.Tender
-	err = tR.DB.Pool.QueryRow(ctx, query, params...).
+	err = tx.QueryRow(ctx, query, update.args...).
 		Scan(&res.ID,
+			&res.OrganizationID,
 			&res.Title,
 			&res.Description,
 			&res.ServiceType,
 			&res.Status,
 			&res.Version,
-			&res.CreatedAt)
+			&res.CreatedAt,
+			&res.CreatorUsername,
+			&res.SubmissionDeadline,
+			&res.ClosedBy)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Tender{}, custom_errors.ErrTenderNotFound
+		}
 		return model.Tender{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
 	}
+
+	err = saveTenderVersion(ctx, tx, res.ID)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".saveTenderVersion, error: {%s}", err.Error())
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
 	return res, nil
 }
 
-func (tR *TenderRepository) GetStatus(ctx context.Context, tenderId uuid.UUID) (string, error) {
+func (tR *TenderRepository) GetStatus(ctx context.Context, tenderId uuid.UUID, user string) (string, error) {
 	path := "internal.repository.tender.GetStatus"
-	sql := `SELECT status FROM tender WHERE id = $1`
+	sql := `SELECT t.status, ` + tenderVisibleTo("t", 2) + `
+	        FROM tender t
+	        WHERE t.id = $1`
 
-	var status string
-	err := tR.DB.Pool.QueryRow(ctx, sql, tenderId).Scan(&status)
+	var (
+		status  string
+		visible bool
+	)
+	err := tR.DB.Querier(ctx).QueryRow(ctx, sql, tenderId, user).Scan(&status, &visible)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if ok := errors.As(err, &pgErr); ok {
@@ -230,36 +323,152 @@ func (tR *TenderRepository) GetStatus(ctx context.Context, tenderId uuid.UUID) (
 		}
 		return "", fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
 	}
+	if !visible {
+		return "", custom_errors.ErrAccessDenied
+	}
 	return status, nil
 }
 
 func (tR *TenderRepository) UpdateStatus(ctx context.Context, tender model.Tender) (model.Tender, error) {
 	path := "internal.repository.tender.UpdateStatus"
-	sql := `SELECT creator_username FROM tender WHERE id = $1`
-	var creatorUsername string
-	err := tR.DB.Pool.QueryRow(ctx, sql, tender.ID).Scan(&creatorUsername)
+	sql := `UPDATE tender SET status = $1, closed_by = $3, updated_at = NOW(), version = version + 1
+              WHERE id = $2
+              RETURNING id, organization_id, title, description, service_type, status, version, created_at,
+                        creator_username, submission_deadline, closed_by`
+
+	tx, err := tR.DB.Begin(ctx)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = lockTenderVersion(ctx, tx, tender.ID, tender.Version)
+	if err != nil {
+		return model.Tender{}, err
+	}
+
+	var res model.Tender
+	err = tx.QueryRow(ctx, sql, tender.Status, tender.ID, tender.ClosedBy).
+		Scan(&res.ID,
+			&res.OrganizationID,
+			&res.Title,
+			&res.Description,
+			&res.ServiceType,
+			&res.Status,
+			&res.Version,
+			&res.CreatedAt,
+			&res.CreatorUsername,
+			&res.SubmissionDeadline,
+			&res.ClosedBy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return model.Tender{}, custom_errors.ErrUserNotFound
+			return model.Tender{}, custom_errors.ErrTenderNotFound
 		}
-		return model.Tender{}, fmt.Errorf(path+".QueryRow (creator check), error: {%s}", err.Error())
+		return model.Tender{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
 	}
-	if creatorUsername != tender.CreatorUsername {
-		return model.Tender{}, custom_errors.ErrAccessDenied
+
+	err = saveTenderVersion(ctx, tx, res.ID)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".saveTenderVersion, error: {%s}", err.Error())
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".Commit, error: {%s}", err.Error())
 	}
-	sql = `UPDATE tender SET status = $1, creator_username = $2, updated_at = NOW(),version = version + 1  
-              WHERE id = $3 RETURNING id, title, description, service_type, status, version, created_at`
+	return res, nil
+}
 
-	var res model.Tender
+func (tR *TenderRepository) RollbackTender(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	version, expectedVersion int,
+) (model.Tender, error) {
+	path := "internal.repository.tender.RollbackTender"
 
-	err = tR.DB.Pool.QueryRow(ctx, sql, tender.Status, tender.CreatorUsername, tender.ID).
+	tx, err := tR.DB.Begin(ctx)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = lockTenderVersion(ctx, tx, tenderId, expectedVersion)
+	if err != nil {
+		return model.Tender{}, err
+	}
+
+	// Откат считается новой правкой: параметры берутся из снимка, версия увеличивается
+	sql := `UPDATE tender t
+	       SET title = v.title,
+	           description = v.description,
+	           service_type = v.service_type,
+	           updated_at = NOW(),
+	           version = t.version + 1
+	       FROM tender_version v
+	       WHERE t.id = $1 AND v.tender_id = t.id AND v.version = $2
+	       RETURNING t.id, t.organization_id, t.title, t.description, t.service_type, t.status, t.version,
+	                 t.created_at, t.creator_username, t.submission_deadline, t.closed_by`
+
+	var res model.Tender
+	err = tx.QueryRow(ctx, sql, tenderId, version).
 		Scan(&res.ID,
+			&res.OrganizationID,
 			&res.Title,
 			&res.Description,
 			&res.ServiceType,
 			&res.Status,
 			&res.Version,
-			&res.CreatedAt)
+			&res.CreatedAt,
+			&res.CreatorUsername,
+			&res.SubmissionDeadline,
+			&res.ClosedBy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Tender{}, custom_errors.ErrVersionNotFound
+		}
+		return model.Tender{}, fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+
+	err = saveTenderVersion(ctx, tx, res.ID)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".saveTenderVersion, error: {%s}", err.Error())
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return res, nil
+}
+
+func (tR *TenderRepository) GetTenderById(ctx context.Context, tenderId uuid.UUID) (model.Tender, error) {
+	path := "internal.repository.tender.GetTenderById"
+	sql := `SELECT id,
+	               organization_id,
+	               title,
+	               description,
+	               service_type,
+	               status,
+	               version,
+	               created_at,
+	               updated_at,
+	               creator_username,
+	               submission_deadline,
+	               closed_by
+	        FROM tender
+	        WHERE id = $1`
+
+	var res model.Tender
+	err := tR.DB.Querier(ctx).QueryRow(ctx, sql, tenderId).Scan(&res.ID,
+		&res.OrganizationID,
+		&res.Title,
+		&res.Description,
+		&res.ServiceType,
+		&res.Status,
+		&res.Version,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+		&res.CreatorUsername,
+		&res.SubmissionDeadline,
+		&res.ClosedBy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return model.Tender{}, custom_errors.ErrTenderNotFound
@@ -268,3 +477,86 @@ func (tR *TenderRepository) UpdateStatus(ctx context.Context, tender model.Tende
 	}
 	return res, nil
 }
+
+// GetExpiredTenders возвращает до limit опубликованных тендеров, срок подачи предложений которых
+// истек к моменту now, начиная с самых просроченных.
+func (tR *TenderRepository) GetExpiredTenders(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	path := "internal.repository.tender.GetExpiredTenders"
+	sql := `SELECT id
+	        FROM tender
+	        WHERE status = $1 AND submission_deadline <= $2
+	        ORDER BY submission_deadline
+	        LIMIT $3`
+
+	rows, err := tR.DB.Querier(ctx).Query(ctx, sql, model.TenderStatusPublished, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf(path+".CollectRows, error: {%s}", err.Error())
+	}
+	return ids, nil
+}
+
+// LockTender блокирует строку тендера до конца транзакции из контекста, чтобы проверки прав и статуса
+// и последующая правка не пересекались с параллельными изменениями.
+func (tR *TenderRepository) LockTender(ctx context.Context, tenderId uuid.UUID) error {
+	return lockTenderVersion(ctx, tR.DB.Querier(ctx), tenderId, 0)
+}
+
+func (tR *TenderRepository) SettleTenderBids(ctx context.Context, tenderId uuid.UUID) error {
+	path := "internal.repository.tender.SettleTenderBids"
+
+	tx, err := tR.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = markBidsLost(ctx, tx, tenderId, uuid.Nil)
+	if err != nil {
+		return fmt.Errorf(path+".markBidsLost, error: {%s}", err.Error())
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return nil
+}
+
+// lockTenderVersion блокирует строку тендера до конца транзакции и сверяет ее версию с ожидаемой.
+// Нулевая ожидаемая версия означает, что клиент не передал If-Match и версия не проверяется.
+func lockTenderVersion(ctx context.Context, tx postgres.Querier, tenderId uuid.UUID, expected int) error {
+	path := "internal.repository.tender.lockTenderVersion"
+	sql := `SELECT version FROM tender WHERE id = $1 FOR UPDATE`
+
+	var current int
+	err := tx.QueryRow(ctx, sql, tenderId).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return custom_errors.ErrTenderNotFound
+		}
+		return fmt.Errorf(path+".QueryRow, error: {%s}", err.Error())
+	}
+	if expected != 0 && current != expected {
+		return custom_errors.ErrVersionConflict
+	}
+	return nil
+}
+
+func saveTenderVersion(ctx context.Context, tx pgx.Tx, tenderId uuid.UUID) error {
+	sql := `INSERT INTO tender_version (tender_id,
+	                            version,
+	                            organization_id,
+	                            title,
+	                            description,
+	                            service_type,
+	                            status,
+	                            creator_username)
+	        SELECT id, version, organization_id, title, description, service_type, status, creator_username
+	        FROM tender
+	        WHERE id = $1`
+	_, err := tx.Exec(ctx, sql, tenderId)
+	return err
+}
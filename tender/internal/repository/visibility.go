@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"zadanie-6105/internal/model"
+)
+
+// Правила видимости, общие для тендеров и предложений. Каждая функция возвращает SQL-условие
+// для таблицы с указанным псевдонимом; имя пользователя передается параметром запроса с номером userParam.
+
+// permittedOrganizations — организации, в которых роль пользователя дает право permission.
+func permittedOrganizations(permission model.Permission, userParam int) string {
+	return fmt.Sprintf(`SELECT r.organization_id
+	        FROM organization_responsible r
+	        JOIN employee e ON e.id = r.user_id
+	        WHERE e.username = $%d AND r.role IN ('%s')`,
+		userParam, strings.Join(model.RolesWithPermission(permission), "', '"))
+}
+
+// tenderVisibleTo: опубликованные тендеры видны всем, остальные — только участникам организации
+// с правом просмотра тендеров.
+func tenderVisibleTo(tender string, userParam int) string {
+	return fmt.Sprintf(`(%[1]s.status = '%[2]s' OR %[1]s.organization_id IN (%[3]s))`,
+		tender, model.TenderStatusPublished, permittedOrganizations(model.PermissionTenderView, userParam))
+}
+
+// bidVisibleTo: предложение видят автор и участники его организации с правом просмотра предложений,
+// а опубликованное — еще и такие же участники организации тендера.
+func bidVisibleTo(bid, tender string, userParam int) string {
+	return fmt.Sprintf(`(%[1]s.creator_username = $%[3]d
+	        OR %[1]s.organization_id IN (%[4]s)
+	        OR (%[1]s.status = '%[5]s' AND %[2]s.organization_id IN (%[4]s)))`,
+		bid, tender, userParam, permittedOrganizations(model.PermissionBidView, userParam), model.BidsStatusPublished)
+}
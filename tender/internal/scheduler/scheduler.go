@@ -0,0 +1,44 @@
+// Package scheduler запускает периодические задачи внутри процесса сервиса. Само расписание не хранится:
+// задача при каждом запуске находит работу в хранилище, поэтому перезапуск сервиса ничего не теряет.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gookit/slog"
+)
+
+type Job func(ctx context.Context) error
+
+// Every выполняет job сразу и затем раз в interval, пока не отменен ctx. Ошибка или паника задачи
+// записываются в лог и не останавливают расписание. Every блокирует вызывающего до отмены ctx
+// и сразу возвращает ошибку, если interval не положителен.
+func Every(ctx context.Context, name string, interval time.Duration, job Job) error {
+	if interval <= 0 {
+		return fmt.Errorf("internal.scheduler.Every %q, error: {interval must be positive, got %s}", name, interval)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		err := run(ctx, job)
+		if err != nil {
+			slog.Errorf("internal.scheduler.Every %q, error: {%s}", name, err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func run(ctx context.Context, job Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return job(ctx)
+}
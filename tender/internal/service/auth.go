@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"zadanie-6105/internal/auth"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/repository"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// dummyPasswordHash сравнивается с паролем, когда сотрудника нет или пароль у него не задан, чтобы время
+// ответа не выдавало, существует ли пользователь. Стоимость совпадает с хешами из CreateEmployee.
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+
+type AuthService struct {
+	employeeRepository repository.IEmployee
+	tokenManager       *auth.TokenManager
+}
+
+func NewAuthService(employeeRepository repository.IEmployee, tokenManager *auth.TokenManager) *AuthService {
+	return &AuthService{
+		employeeRepository: employeeRepository,
+		tokenManager:       tokenManager,
+	}
+}
+
+func (aS *AuthService) Login(ctx context.Context, username, password string) (string, time.Time, error) {
+	employee, err := aS.employeeRepository.GetEmployeeCredentials(ctx, username)
+	if err != nil && !errors.Is(err, custom_errors.ErrUserNotFound) {
+		return "", time.Time{}, err
+	}
+	// Неизвестные сотрудники и сотрудники без пароля (например, из начальных данных) входить не могут.
+	// Пароль все равно сравнивается с хешем-заглушкой, чтобы отказ занимал столько же времени, сколько неверный пароль
+	canLogin := err == nil && employee.PasswordHash != ""
+	hash := dummyPasswordHash
+	if canLogin {
+		hash = []byte(employee.PasswordHash)
+	}
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil || !canLogin {
+		return "", time.Time{}, custom_errors.ErrInvalidCredentials
+	}
+	return aS.tokenManager.Issue(employee)
+}
+
+func (aS *AuthService) Authenticate(ctx context.Context, token string) (model.Employee, error) {
+	identity, err := aS.tokenManager.Parse(token)
+	if err != nil {
+		return model.Employee{}, err
+	}
+	// Токен удаленного сотрудника больше не действует, в том числе если его имя занял новый сотрудник
+	employee, err := aS.employeeRepository.GetEmployee(ctx, identity.Username)
+	if err != nil {
+		if errors.Is(err, custom_errors.ErrUserNotFound) {
+			return model.Employee{}, custom_errors.ErrInvalidToken
+		}
+		return model.Employee{}, err
+	}
+	if employee.ID != identity.EmployeeID {
+		return model.Employee{}, custom_errors.ErrInvalidToken
+	}
+	return employee, nil
+}
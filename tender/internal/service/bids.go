@@ -4,74 +4,162 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
+	"unicode/utf8"
 	custom_errors "zadanie-6105/internal/custom-errors"
 	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/policy"
 	"zadanie-6105/internal/repository"
+	"zadanie-6105/internal/statemachine"
 
 	"github.com/google/uuid"
 )
 
 type BidsService struct {
-	bidsRepository repository.IBids
+	bidsRepository     repository.IBids
+	tenderRepository   repository.ITender
+	feedbackRepository repository.IFeedback
+	transactor         repository.Transactor
+	policy             *policy.Policy
+	machine            *statemachine.Machine
 }
 
-func NewBidsService(bidsRepository repository.IBids) *BidsService {
+func NewBidsService(
+	bidsRepository repository.IBids,
+	tenderRepository repository.ITender,
+	feedbackRepository repository.IFeedback,
+	transactor repository.Transactor,
+	accessPolicy *policy.Policy,
+) *BidsService {
 	return &BidsService{
-		bidsRepository: bidsRepository,
+		bidsRepository:     bidsRepository,
+		tenderRepository:   tenderRepository,
+		feedbackRepository: feedbackRepository,
+		transactor:         transactor,
+		policy:             accessPolicy,
+		machine:            newBidsMachine(),
 	}
 }
 
 func (bS *BidsService) CreateBids(ctx context.Context, bids *model.Bids) (model.Bids, error) {
-	path := "service.bidss.CreateBids"
-	isAuthorized, err := bS.bidsRepository.IsUserAuthorizedToCreateBid(ctx, bids)
-	if err != nil {
-		return model.Bids{}, fmt.Errorf(path+".IsUserAuthorizedToCreateBid, error: {%w}", err)
-	}
-	if !isAuthorized {
-		return model.Bids{}, fmt.Errorf(path+".IsUserAuthorizedToCreateBid, error: {%w}", custom_errors.ErrAccessDenied)
-	}
+	return inTx(ctx, bS.transactor, func(ctx context.Context) (model.Bids, error) {
+		path := "service.bidss.CreateBids"
+		err := bS.policy.Authorize(ctx, bids.CreatorUsername, bids.OrganizationID, model.PermissionBidManage)
+		if err != nil {
+			return model.Bids{}, fmt.Errorf(path+".Authorize, error: {%w}", err)
+		}
 
-	isValidTender, err := bS.bidsRepository.IsTenderValid(ctx, bids.TenderID)
-	if err != nil {
-		return model.Bids{}, fmt.Errorf(path+".IsTenderValid, error: {%w}", err)
-	}
-	if !isValidTender {
-		return model.Bids{}, fmt.Errorf(path+".IsUserAuthorizedToCreateBid, error: {%w}", errors.New("tender not found"))
-	}
-	bids.Status = "CREATED"
-	return bS.bidsRepository.CreateBids(ctx, bids)
+		// Блокировка тендера не дает закрыть его, пока предложение еще не сохранено
+		err = bS.tenderRepository.LockTender(ctx, bids.TenderID)
+		if err != nil {
+			return model.Bids{}, fmt.Errorf(path+".LockTender, error: {%w}", err)
+		}
+		isValidTender, err := bS.bidsRepository.IsTenderValid(ctx, bids.TenderID)
+		if err != nil {
+			return model.Bids{}, fmt.Errorf(path+".IsTenderValid, error: {%w}", err)
+		}
+		if !isValidTender {
+			return model.Bids{}, fmt.Errorf(path+".IsTenderValid, error: {%w}", custom_errors.ErrTenderNotFound)
+		}
+		// Тендер с истекшим сроком мог еще не попасть к планировщику, поэтому срок проверяется здесь
+		tender, err := bS.tenderRepository.GetTenderById(ctx, bids.TenderID)
+		if err != nil {
+			return model.Bids{}, fmt.Errorf(path+".GetTenderById, error: {%w}", err)
+		}
+		if tender.DeadlinePassed(time.Now()) {
+			return model.Bids{}, custom_errors.ErrSubmissionDeadlinePassed
+		}
+		bids.Status = model.BidsStatusCreated
+		return bS.bidsRepository.CreateBids(ctx, bids)
+	})
 }
 
-func (bs *BidsService) GetBids(ctx context.Context, user string, limit, offset int) ([]model.Bids, error) {
-	return bs.bidsRepository.GetBids(ctx, user, limit, offset)
+func (bs *BidsService) GetBids(ctx context.Context, user string, page model.Page) (model.List[model.Bids], error) {
+	err := bs.policy.Authenticate(ctx, user)
+	if err != nil {
+		return model.List[model.Bids]{}, err
+	}
+	return bs.bidsRepository.GetBids(ctx, user, page)
 }
 
 func (bs *BidsService) GetBidsByTenderId(
 	ctx context.Context,
 	user string,
 	tenderId uuid.UUID,
-	limit, offset int,
-) ([]model.Bids, error) {
-	exists, err := bs.bidsRepository.CheckUserExists(ctx, user)
+	page model.Page,
+) (model.List[model.Bids], error) {
+	// Видимость отдельных предложений проверяется в запросе по тем же правилам, что и в Policy
+	err := bs.policy.Authenticate(ctx, user)
 	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		return []model.Bids{}, custom_errors.ErrUserNotFound
+		return model.List[model.Bids]{}, err
 	}
-	return bs.bidsRepository.GetBidsByTenderId(ctx, user, tenderId, limit, offset)
+	return bs.bidsRepository.GetBidsByTenderId(ctx, user, tenderId, page)
 }
 
+// UpdateBids применяет правку. Ненулевой bids.Version — версия, которую видел клиент (If-Match):
+// если предложение успели изменить, возвращается его актуальное состояние и ErrVersionConflict.
 func (bs *BidsService) UpdateBids(ctx context.Context, bids *model.Bids) (model.Bids, error) {
-	return bs.bidsRepository.UpdateBids(ctx, bids)
+	return inTx(ctx, bs.transactor, func(ctx context.Context) (model.Bids, error) {
+		current, err := bs.lockAndAuthorizeBid(ctx, bids.ID, bids.CreatorUsername)
+		if err != nil {
+			return model.Bids{}, err
+		}
+		if staleVersion(current.Version, bids.Version) {
+			return current, custom_errors.ErrVersionConflict
+		}
+		res, err := bs.bidsRepository.UpdateBids(ctx, bids)
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return bs.versionConflict(ctx, bids.ID)
+		}
+		return res, err
+	})
 }
 
 func (bs *BidsService) GetBidStatus(ctx context.Context, bidId uuid.UUID, user string) (string, error) {
+	err := bs.policy.Authenticate(ctx, user)
+	if err != nil {
+		return "", err
+	}
 	return bs.bidsRepository.GetBidStatus(ctx, bidId, user)
 }
 
+// UpdateBidsStatus меняет статус предложения; bids.Version проверяется так же, как в UpdateBids.
 func (bs *BidsService) UpdateBidsStatus(ctx context.Context, bids model.Bids) (model.Bids, error) {
-	return bs.bidsRepository.UpdateBidsStatus(ctx, bids)
+	return inTx(ctx, bs.transactor, func(ctx context.Context) (model.Bids, error) {
+		path := "service.bids.UpdateBidsStatus"
+		if !bs.machine.HasState(bids.Status) {
+			return model.Bids{}, custom_errors.ErrUnprocessableEntity
+		}
+		current, err := bs.lockAndAuthorizeBid(ctx, bids.ID, bids.CreatorUsername)
+		if err != nil {
+			return model.Bids{}, err
+		}
+		if staleVersion(current.Version, bids.Version) {
+			return current, custom_errors.ErrVersionConflict
+		}
+
+		roles := []statemachine.Role{statemachine.RoleResponsible}
+		if current.CreatorUsername == bids.CreatorUsername {
+			roles = append(roles, statemachine.RoleAuthor)
+		}
+		err = bs.machine.Check(current.Status, bids.Status, roles...)
+		if err != nil {
+			return model.Bids{}, err
+		}
+
+		res, err := bs.bidsRepository.UpdateBidsStatus(ctx, bids)
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return bs.versionConflict(ctx, bids.ID)
+		}
+		if err != nil {
+			return model.Bids{}, err
+		}
+		err = bs.machine.Apply(ctx, res.Status, res.ID)
+		if err != nil {
+			return model.Bids{}, fmt.Errorf(path+".Apply, error: {%w}", err)
+		}
+		return res, nil
+	})
 }
 
 func (bs *BidsService) UpdateBidsDecision(
@@ -79,8 +167,231 @@ func (bs *BidsService) UpdateBidsDecision(
 	bidId uuid.UUID,
 	decision, username string,
 ) (model.Bids, error) {
-	if decision != "Approved" && decision != "Rejected" {
-		return model.Bids{}, custom_errors.ErrUnprocessableEntity
+	return inTx(ctx, bs.transactor, func(ctx context.Context) (model.Bids, error) {
+		if decision != model.BidDecisionApproved && decision != model.BidDecisionRejected {
+			return model.Bids{}, custom_errors.ErrUnprocessableEntity
+		}
+		_, err := bs.authorizeBidReview(ctx, bidId, username)
+		if err != nil {
+			return model.Bids{}, err
+		}
+		return bs.bidsRepository.UpdateBidsDecision(ctx, bidId, decision, username)
+	})
+}
+
+func (bs *BidsService) GetBidDecisions(
+	ctx context.Context,
+	bidId uuid.UUID,
+	username string,
+) ([]model.BidDecision, error) {
+	path := "service.bids.GetBidDecisions"
+	// Голоса видят рецензенты организации тендера и участники организации предложения
+	current, err := bs.authorizeBidReview(ctx, bidId, username)
+	if errors.Is(err, custom_errors.ErrAccessDenied) {
+		err = bs.policy.Authorize(ctx, username, current.OrganizationID, model.PermissionBidView)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(path+".Authorize, error: {%w}", err)
+	}
+	return bs.bidsRepository.GetBidDecisions(ctx, bidId)
+}
+
+func (bs *BidsService) GetBidVersions(
+	ctx context.Context,
+	bidId uuid.UUID,
+	username string,
+	limit, offset int,
+) ([]model.Bids, error) {
+	_, err := bs.authorizeBid(ctx, bidId, username, model.PermissionBidView)
+	if err != nil {
+		return nil, err
+	}
+	return bs.bidsRepository.GetBidVersions(ctx, bidId, limit, offset)
+}
+
+func (bs *BidsService) GetBidVersion(
+	ctx context.Context,
+	bidId uuid.UUID,
+	version int,
+	username string,
+) (model.Bids, error) {
+	_, err := bs.authorizeBid(ctx, bidId, username, model.PermissionBidView)
+	if err != nil {
+		return model.Bids{}, err
+	}
+	return bs.bidsRepository.GetBidVersion(ctx, bidId, version)
+}
+
+// RollbackBids откатывает предложение к версии version; ненулевой expectedVersion — ожидаемая текущая версия.
+func (bs *BidsService) RollbackBids(
+	ctx context.Context,
+	bidId uuid.UUID,
+	version, expectedVersion int,
+	username string,
+) (model.Bids, error) {
+	return inTx(ctx, bs.transactor, func(ctx context.Context) (model.Bids, error) {
+		if version < 1 {
+			return model.Bids{}, custom_errors.ErrUnprocessableEntity
+		}
+		current, err := bs.lockAndAuthorizeBidAuthor(ctx, bidId, username)
+		if err != nil {
+			return model.Bids{}, err
+		}
+		if staleVersion(current.Version, expectedVersion) {
+			return current, custom_errors.ErrVersionConflict
+		}
+		res, err := bs.bidsRepository.RollbackBids(ctx, bidId, version, expectedVersion)
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return bs.versionConflict(ctx, bidId)
+		}
+		return res, err
+	})
+}
+
+// versionConflict возвращает актуальное состояние предложения вместе с ErrVersionConflict.
+func (bs *BidsService) versionConflict(ctx context.Context, bidId uuid.UUID) (model.Bids, error) {
+	path := "service.bids.versionConflict"
+	current, err := bs.bidsRepository.GetBidById(ctx, bidId)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".GetBidById, error: {%w}", err)
+	}
+	return current, custom_errors.ErrVersionConflict
+}
+
+func (bs *BidsService) CreateBidFeedback(
+	ctx context.Context,
+	bidId uuid.UUID,
+	feedback, username string,
+) (model.Bids, error) {
+	return inTx(ctx, bs.transactor, func(ctx context.Context) (model.Bids, error) {
+		path := "service.bids.CreateBidFeedback"
+		if feedback == "" || utf8.RuneCountInString(feedback) > 1000 {
+			return model.Bids{}, custom_errors.ErrUnprocessableEntity
+		}
+		_, err := bs.authorizeBidReview(ctx, bidId, username)
+		if err != nil {
+			return model.Bids{}, err
+		}
+		// Отзыв оставляют только на предложение, которое рецензент видит, то есть на опубликованное
+		_, err = bs.bidsRepository.GetBidStatus(ctx, bidId, username)
+		if err != nil {
+			return model.Bids{}, err
+		}
+		_, err = bs.feedbackRepository.CreateBidFeedback(ctx, model.BidFeedback{
+			BidID:          bidId,
+			Description:    feedback,
+			AuthorUsername: username,
+		})
+		if err != nil {
+			return model.Bids{}, fmt.Errorf(path+".CreateBidFeedback, error: {%w}", err)
+		}
+		return bs.bidsRepository.GetBidById(ctx, bidId)
+	})
+}
+
+func (bs *BidsService) GetBidReviews(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	authorUsername, requesterUsername string,
+	limit, offset int,
+) ([]model.BidFeedback, error) {
+	path := "service.bids.GetBidReviews"
+	tender, err := bs.tenderRepository.GetTenderById(ctx, tenderId)
+	if err != nil {
+		return nil, fmt.Errorf(path+".GetTenderById, error: {%w}", err)
+	}
+	err = bs.policy.Authorize(ctx, requesterUsername, tender.OrganizationID, model.PermissionBidReview)
+	if err != nil {
+		return nil, err
+	}
+	// Автор отзывов — объект запроса, а не тот, кто его выполняет, поэтому его отсутствие — это 404
+	err = bs.policy.Authenticate(ctx, authorUsername)
+	if errors.Is(err, custom_errors.ErrUserNotFound) {
+		return nil, custom_errors.ErrEmployeeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	hasBid, err := bs.feedbackRepository.HasAuthorBidOnTender(ctx, tenderId, authorUsername)
+	if err != nil {
+		return nil, fmt.Errorf(path+".HasAuthorBidOnTender, error: {%w}", err)
+	}
+	if !hasBid {
+		return nil, custom_errors.ErrBidsNotFound
+	}
+	return bs.feedbackRepository.GetAuthorReviews(ctx, authorUsername, limit, offset)
+}
+
+// authorizeBid загружает предложение и проверяет право пользователя в организации, от имени которой оно подано.
+func (bs *BidsService) authorizeBid(
+	ctx context.Context,
+	bidId uuid.UUID,
+	username string,
+	permission model.Permission,
+) (model.Bids, error) {
+	path := "service.bids.authorizeBid"
+	current, err := bs.bidsRepository.GetBidById(ctx, bidId)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".GetBidById, error: {%w}", err)
+	}
+	err = bs.policy.Authorize(ctx, username, current.OrganizationID, permission)
+	if err != nil {
+		return model.Bids{}, err
+	}
+	return current, nil
+}
+
+// lockAndAuthorizeBid блокирует предложение до конца единицы работы и проверяет право управлять им.
+func (bs *BidsService) lockAndAuthorizeBid(ctx context.Context, bidId uuid.UUID, username string) (model.Bids, error) {
+	path := "service.bids.lockAndAuthorizeBid"
+	err := bs.bidsRepository.LockBid(ctx, bidId)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".LockBid, error: {%w}", err)
+	}
+	return bs.authorizeBid(ctx, bidId, username, model.PermissionBidManage)
+}
+
+// lockAndAuthorizeBidAuthor блокирует предложение до конца единицы работы и пропускает его автора,
+// остальным нужно право управлять предложением в его организации.
+func (bs *BidsService) lockAndAuthorizeBidAuthor(
+	ctx context.Context,
+	bidId uuid.UUID,
+	username string,
+) (model.Bids, error) {
+	path := "service.bids.lockAndAuthorizeBidAuthor"
+	err := bs.bidsRepository.LockBid(ctx, bidId)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".LockBid, error: {%w}", err)
+	}
+	current, err := bs.bidsRepository.GetBidById(ctx, bidId)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".GetBidById, error: {%w}", err)
+	}
+	if current.CreatorUsername == username {
+		return current, nil
+	}
+	err = bs.policy.Authorize(ctx, username, current.OrganizationID, model.PermissionBidManage)
+	if err != nil {
+		return model.Bids{}, err
+	}
+	return current, nil
+}
+
+// authorizeBidReview проверяет право рассматривать предложение в организации, которой принадлежит тендер.
+// Предложение возвращается и при отказе в доступе, чтобы вызывающий мог проверить другие права.
+func (bs *BidsService) authorizeBidReview(ctx context.Context, bidId uuid.UUID, username string) (model.Bids, error) {
+	path := "service.bids.authorizeBidReview"
+	current, err := bs.bidsRepository.GetBidById(ctx, bidId)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".GetBidById, error: {%w}", err)
+	}
+	tender, err := bs.tenderRepository.GetTenderById(ctx, current.TenderID)
+	if err != nil {
+		return model.Bids{}, fmt.Errorf(path+".GetTenderById, error: {%w}", err)
+	}
+	err = bs.policy.Authorize(ctx, username, tender.OrganizationID, model.PermissionBidReview)
+	if err != nil {
+		return current, err
 	}
-	return bs.bidsRepository.UpdateBidsDecision(ctx, bidId, decision, username)
+	return current, nil
 }
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/policy"
+	"zadanie-6105/internal/repository"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type EmployeeService struct {
+	employeeRepository repository.IEmployee
+	policy             *policy.Policy
+}
+
+func NewEmployeeService(employeeRepository repository.IEmployee, accessPolicy *policy.Policy) *EmployeeService {
+	return &EmployeeService{
+		employeeRepository: employeeRepository,
+		policy:             accessPolicy,
+	}
+}
+
+func (eS *EmployeeService) CreateEmployee(
+	ctx context.Context,
+	employee model.Employee,
+	password string,
+) (model.Employee, error) {
+	path := "internal.service.employee.CreateEmployee"
+
+	if employee.Username == "" || !isValidEmployee(employee) {
+		return model.Employee{}, custom_errors.ErrUnprocessableEntity
+	}
+	// bcrypt учитывает только первые 72 байта пароля
+	if utf8.RuneCountInString(password) < 8 || len(password) > 72 {
+		return model.Employee{}, custom_errors.ErrUnprocessableEntity
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return model.Employee{}, fmt.Errorf(path+".GenerateFromPassword, error: {%s}", err.Error())
+	}
+	employee.PasswordHash = string(hash)
+	return eS.employeeRepository.CreateEmployee(ctx, employee)
+}
+
+func (eS *EmployeeService) GetEmployees(ctx context.Context, limit, offset int) ([]model.Employee, error) {
+	return eS.employeeRepository.GetEmployees(ctx, limit, offset)
+}
+
+func (eS *EmployeeService) GetEmployee(ctx context.Context, username string) (model.Employee, error) {
+	return eS.employeeRepository.GetEmployee(ctx, username)
+}
+
+func (eS *EmployeeService) UpdateEmployee(
+	ctx context.Context,
+	employee model.Employee,
+	username string,
+) (model.Employee, error) {
+	if !isValidEmployee(employee) {
+		return model.Employee{}, custom_errors.ErrUnprocessableEntity
+	}
+	// Профиль может менять только сам пользователь
+	if employee.Username != username {
+		return model.Employee{}, custom_errors.ErrAccessDenied
+	}
+	return eS.employeeRepository.UpdateEmployee(ctx, employee)
+}
+
+func (eS *EmployeeService) DeleteEmployee(ctx context.Context, employeeUsername, username string) error {
+	if employeeUsername != username {
+		return custom_errors.ErrAccessDenied
+	}
+	return eS.employeeRepository.DeleteEmployee(ctx, employeeUsername)
+}
+
+func (eS *EmployeeService) GetEmployeePermissions(
+	ctx context.Context,
+	employeeUsername, username string,
+) ([]model.OrganizationPermissions, error) {
+	err := eS.policy.Authenticate(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	// Свои права пользователь видит сам; права других сотрудников не раскрываются
+	if employeeUsername != username {
+		return nil, custom_errors.ErrAccessDenied
+	}
+	return eS.policy.Permissions(ctx, employeeUsername)
+}
+
+func isValidEmployee(employee model.Employee) bool {
+	return utf8.RuneCountInString(employee.Username) <= 50 &&
+		utf8.RuneCountInString(employee.FirstName) <= 50 &&
+		utf8.RuneCountInString(employee.LastName) <= 50
+}
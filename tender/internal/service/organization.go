@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/policy"
+	"zadanie-6105/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationService struct {
+	organizationRepository repository.IOrganization
+	policy                 *policy.Policy
+}
+
+func NewOrganizationService(
+	organizationRepository repository.IOrganization,
+	accessPolicy *policy.Policy,
+) *OrganizationService {
+	return &OrganizationService{
+		organizationRepository: organizationRepository,
+		policy:                 accessPolicy,
+	}
+}
+
+func (oS *OrganizationService) CreateOrganization(
+	ctx context.Context,
+	organization model.Organization,
+	username string,
+) (model.Organization, error) {
+	path := "service.organization.CreateOrganization"
+	if organization.Name == "" || !organization.Type.IsValid() || !isValidOrganization(organization) {
+		return model.Organization{}, custom_errors.ErrUnprocessableEntity
+	}
+	err := oS.policy.Authenticate(ctx, username)
+	if err != nil {
+		return model.Organization{}, fmt.Errorf(path+".Authenticate, error: {%w}", err)
+	}
+	return oS.organizationRepository.CreateOrganization(ctx, organization, username)
+}
+
+func (oS *OrganizationService) GetOrganizations(ctx context.Context, limit, offset int) ([]model.Organization, error) {
+	return oS.organizationRepository.GetOrganizations(ctx, limit, offset)
+}
+
+func (oS *OrganizationService) GetOrganization(
+	ctx context.Context,
+	organizationId uuid.UUID,
+) (model.Organization, error) {
+	return oS.organizationRepository.GetOrganization(ctx, organizationId)
+}
+
+func (oS *OrganizationService) UpdateOrganization(
+	ctx context.Context,
+	organization model.Organization,
+	username string,
+) (model.Organization, error) {
+	if (organization.Type != "" && !organization.Type.IsValid()) || !isValidOrganization(organization) {
+		return model.Organization{}, custom_errors.ErrUnprocessableEntity
+	}
+	err := oS.policy.Authorize(ctx, username, organization.ID, model.PermissionOrganizationManage)
+	if err != nil {
+		return model.Organization{}, err
+	}
+	return oS.organizationRepository.UpdateOrganization(ctx, organization)
+}
+
+func (oS *OrganizationService) DeleteOrganization(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	username string,
+) error {
+	err := oS.policy.Authorize(ctx, username, organizationId, model.PermissionOrganizationManage)
+	if err != nil {
+		return err
+	}
+	return oS.organizationRepository.DeleteOrganization(ctx, organizationId)
+}
+
+func (oS *OrganizationService) GetResponsibles(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	limit, offset int,
+) ([]model.OrganizationResponsible, error) {
+	path := "service.organization.GetResponsibles"
+	_, err := oS.organizationRepository.GetOrganization(ctx, organizationId)
+	if err != nil {
+		return nil, fmt.Errorf(path+".GetOrganization, error: {%w}", err)
+	}
+	return oS.organizationRepository.GetResponsibles(ctx, organizationId, limit, offset)
+}
+
+func (oS *OrganizationService) AddResponsible(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	employeeUsername string,
+	role model.OrganizationRole,
+	username string,
+) error {
+	if !role.IsValid() {
+		return custom_errors.ErrUnprocessableEntity
+	}
+	err := oS.policy.Authorize(ctx, username, organizationId, model.PermissionOrganizationManage)
+	if err != nil {
+		return err
+	}
+	return oS.organizationRepository.AddResponsible(ctx, organizationId, employeeUsername, role)
+}
+
+func (oS *OrganizationService) UpdateResponsibleRole(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	employeeUsername string,
+	role model.OrganizationRole,
+	username string,
+) error {
+	if !role.IsValid() {
+		return custom_errors.ErrUnprocessableEntity
+	}
+	err := oS.policy.Authorize(ctx, username, organizationId, model.PermissionOrganizationManage)
+	if err != nil {
+		return err
+	}
+	return oS.organizationRepository.UpdateResponsibleRole(ctx, organizationId, employeeUsername, role)
+}
+
+func (oS *OrganizationService) RemoveResponsible(
+	ctx context.Context,
+	organizationId uuid.UUID,
+	employeeUsername, username string,
+) error {
+	err := oS.policy.Authorize(ctx, username, organizationId, model.PermissionOrganizationManage)
+	if err != nil {
+		return err
+	}
+	return oS.organizationRepository.RemoveResponsible(ctx, organizationId, employeeUsername)
+}
+
+func isValidOrganization(organization model.Organization) bool {
+	return utf8.RuneCountInString(organization.Name) <= 100 &&
+		utf8.RuneCountInString(organization.Description) <= 500
+}
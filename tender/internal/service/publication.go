@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	custom_errors "zadanie-6105/internal/custom-errors"
+	"zadanie-6105/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// publishBatch — сколько отложенных публикаций PublishDue выполняет за один вызов.
+const publishBatch = 100
+
+// validPublishAt проверяет время отложенной публикации: оно должно быть в будущем и раньше срока подачи
+// предложений, иначе тендер опубликовать уже не получится.
+func validPublishAt(tender model.Tender, publishAt, now time.Time) error {
+	if !publishAt.After(now) || tender.DeadlinePassed(publishAt) {
+		return custom_errors.ErrInvalidPublishAt
+	}
+	return nil
+}
+
+func (tS *TenderService) GetPublication(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	user string,
+) (model.TenderPublication, error) {
+	return inTx(ctx, tS.transactor, func(ctx context.Context) (model.TenderPublication, error) {
+		_, err := tS.authorizeTender(ctx, tenderId, user)
+		if err != nil {
+			return model.TenderPublication{}, err
+		}
+		return tS.publicationRepository.GetPublication(ctx, tenderId)
+	})
+}
+
+// SchedulePublication планирует публикацию тендера на publishAt или переносит уже запланированную.
+// Планировать можно только тендер, который пользователь и сам мог бы опубликовать, то есть в статусе Created;
+// публикация выполнится от его имени.
+func (tS *TenderService) SchedulePublication(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	publishAt time.Time,
+	user string,
+) (model.TenderPublication, error) {
+	return inTx(ctx, tS.transactor, func(ctx context.Context) (model.TenderPublication, error) {
+		current, err := tS.authorizeTender(ctx, tenderId, user)
+		if err != nil {
+			return model.TenderPublication{}, err
+		}
+		err = tS.checkTransition(ctx, current, model.TenderStatusPublished, user)
+		if err != nil {
+			return model.TenderPublication{}, err
+		}
+		err = validPublishAt(current, publishAt, time.Now())
+		if err != nil {
+			return model.TenderPublication{}, err
+		}
+		return tS.publicationRepository.SavePublication(ctx, model.TenderPublication{
+			TenderID:  tenderId,
+			PublishAt: publishAt,
+			CreatedBy: user,
+		})
+	})
+}
+
+// CancelPublication отменяет запланированную публикацию. Выполненную или уже отмененную отменить нельзя.
+func (tS *TenderService) CancelPublication(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	user string,
+) (model.TenderPublication, error) {
+	return inTx(ctx, tS.transactor, func(ctx context.Context) (model.TenderPublication, error) {
+		_, err := tS.authorizeTender(ctx, tenderId, user)
+		if err != nil {
+			return model.TenderPublication{}, err
+		}
+		publication, err := tS.publicationRepository.GetPublication(ctx, tenderId)
+		if err != nil {
+			return model.TenderPublication{}, err
+		}
+		if publication.Status != model.PublicationPending {
+			return model.TenderPublication{}, custom_errors.ErrPublicationNotPending
+		}
+		return tS.publicationRepository.SetPublicationStatus(ctx, tenderId, model.PublicationCanceled, "")
+	})
+}
+
+// PublishDue публикует тендеры, время отложенной публикации которых наступило к моменту now, через
+// UpdateStatus от имени запланировавшего пользователя. Если публикация отклонена по правилам предметной
+// области, например у пользователя больше нет прав, она помечается model.PublicationFailed с кодом ошибки
+// и не повторяется; остальные ошибки оставляют ее в ожидании до следующего вызова. Возвращает число
+// опубликованных.
+func (tS *TenderService) PublishDue(ctx context.Context, now time.Time) (int, error) {
+	path := "service.tender.PublishDue"
+	ids, err := tS.publicationRepository.GetDuePublications(ctx, now, publishBatch)
+	if err != nil {
+		return 0, fmt.Errorf(path+".GetDuePublications, error: {%w}", err)
+	}
+	var published int
+	var errs []error
+	for _, id := range ids {
+		ok, err := tS.publishDue(ctx, id, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(path+".publishDue %s, error: {%w}", id, err))
+			continue
+		}
+		if ok {
+			published++
+		}
+	}
+	return published, errors.Join(errs...)
+}
+
+func (tS *TenderService) publishDue(ctx context.Context, tenderId uuid.UUID, now time.Time) (bool, error) {
+	return inTx(ctx, tS.transactor, func(ctx context.Context) (bool, error) {
+		err := tS.tenderRepository.LockTender(ctx, tenderId)
+		if err != nil {
+			return false, err
+		}
+		publication, err := tS.publicationRepository.GetPublication(ctx, tenderId)
+		if err != nil {
+			return false, err
+		}
+		// Пока шел обход, публикацию могли отменить или перенести
+		if publication.Status != model.PublicationPending || publication.PublishAt.After(now) {
+			return false, nil
+		}
+		// Итог записывается до смены статуса, чтобы UpdateStatus не принял публикацию за ручную
+		_, err = tS.publicationRepository.SetPublicationStatus(ctx, tenderId, model.PublicationDone, "")
+		if err != nil {
+			return false, err
+		}
+		_, err = tS.UpdateStatus(ctx, model.Tender{
+			ID:              tenderId,
+			Status:          model.TenderStatusPublished,
+			CreatorUsername: publication.CreatedBy,
+		})
+		var domainErr *custom_errors.Error
+		if errors.As(err, &domainErr) {
+			_, err = tS.publicationRepository.SetPublicationStatus(ctx, tenderId, model.PublicationFailed, domainErr.Code)
+			return false, err
+		}
+		return err == nil, err
+	})
+}
+
+// dropPublication отменяет ожидающую публикацию, если тендер вывели из Created в обход нее.
+func (tS *TenderService) dropPublication(ctx context.Context, current, res model.Tender) error {
+	if current.Status != model.TenderStatusCreated || res.Status == model.TenderStatusCreated {
+		return nil
+	}
+	publication, err := tS.publicationRepository.GetPublication(ctx, res.ID)
+	if errors.Is(err, custom_errors.ErrPublicationNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if publication.Status != model.PublicationPending {
+		return nil
+	}
+	_, err = tS.publicationRepository.SetPublicationStatus(ctx, res.ID, model.PublicationCanceled, "")
+	return err
+}
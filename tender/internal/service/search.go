@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/policy"
+	"zadanie-6105/internal/repository"
+)
+
+type SearchService struct {
+	searchRepository repository.ISearch
+	policy           *policy.Policy
+}
+
+func NewSearchService(searchRepository repository.ISearch, accessPolicy *policy.Policy) *SearchService {
+	return &SearchService{
+		searchRepository: searchRepository,
+		policy:           accessPolicy,
+	}
+}
+
+// SearchTenders ищет среди тендеров, которые пользователь видит в общем списке: анонимный пользователь
+// находит только опубликованные.
+func (sS *SearchService) SearchTenders(
+	ctx context.Context,
+	user string,
+	search model.TenderSearch,
+) ([]model.TenderSearchResult, error) {
+	if user != "" {
+		err := sS.policy.Authenticate(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sS.searchRepository.SearchTenders(ctx, user, search)
+}
@@ -2,37 +2,124 @@ package service
 
 import (
 	"context"
+	"time"
+	"zadanie-6105/internal/auth"
 	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/policy"
 	"zadanie-6105/internal/repository"
 
 	"github.com/google/uuid"
 )
 
 type ITender interface {
-	GetTenders(ctx context.Context, limit int, offset int, serviceTypesArr []string) ([]model.Tender, error)
+	GetTenders(
+		ctx context.Context, user string, page model.Page, filter model.TenderFilter,
+	) (model.List[model.Tender], error)
 	CreateTender(ctx context.Context, tender model.Tender) (model.Tender, error)
-	GetTender(ctx context.Context, user string, limit int, offset int) ([]model.Tender, error)
+	GetTender(ctx context.Context, user string, page model.Page) (model.List[model.Tender], error)
 	UpdateTender(ctx context.Context, tender model.Tender) (model.Tender, error)
-	GetStatus(ctx context.Context, tenderId uuid.UUID) (string, error)
+	GetStatus(ctx context.Context, tenderId uuid.UUID, user string) (string, error)
 	UpdateStatus(ctx context.Context, tender model.Tender) (model.Tender, error)
+	RollbackTender(ctx context.Context, tender model.Tender, version int) (model.Tender, error)
+	CloseExpired(ctx context.Context, now time.Time) (int, error)
+	GetPublication(ctx context.Context, tenderId uuid.UUID, user string) (model.TenderPublication, error)
+	SchedulePublication(
+		ctx context.Context,
+		tenderId uuid.UUID,
+		publishAt time.Time,
+		user string,
+	) (model.TenderPublication, error)
+	CancelPublication(ctx context.Context, tenderId uuid.UUID, user string) (model.TenderPublication, error)
+	PublishDue(ctx context.Context, now time.Time) (int, error)
 }
 type IBids interface {
 	CreateBids(ctx context.Context, bids *model.Bids) (model.Bids, error)
-	GetBids(ctx context.Context, user string, limit, offset int) ([]model.Bids, error)
-	GetBidsByTenderId(ctx context.Context, user string, tenderId uuid.UUID, limit, offset int) ([]model.Bids, error)
+	GetBids(ctx context.Context, user string, page model.Page) (model.List[model.Bids], error)
+	GetBidsByTenderId(
+		ctx context.Context, user string, tenderId uuid.UUID, page model.Page,
+	) (model.List[model.Bids], error)
 	UpdateBids(ctx context.Context, bids *model.Bids) (model.Bids, error)
 	GetBidStatus(ctx context.Context, bidId uuid.UUID, user string) (string, error)
 	UpdateBidsStatus(ctx context.Context, bids model.Bids) (model.Bids, error)
 	UpdateBidsDecision(ctx context.Context, bidId uuid.UUID, decision, username string) (model.Bids, error)
+	GetBidVersions(ctx context.Context, bidId uuid.UUID, username string, limit, offset int) ([]model.Bids, error)
+	GetBidVersion(ctx context.Context, bidId uuid.UUID, version int, username string) (model.Bids, error)
+	RollbackBids(ctx context.Context, bidId uuid.UUID, version, expectedVersion int, username string) (model.Bids, error)
+	CreateBidFeedback(ctx context.Context, bidId uuid.UUID, feedback, username string) (model.Bids, error)
+	GetBidReviews(
+		ctx context.Context,
+		tenderId uuid.UUID,
+		authorUsername, requesterUsername string,
+		limit, offset int,
+	) ([]model.BidFeedback, error)
+	GetBidDecisions(ctx context.Context, bidId uuid.UUID, username string) ([]model.BidDecision, error)
+}
+type IOrganization interface {
+	CreateOrganization(ctx context.Context, organization model.Organization, username string) (model.Organization, error)
+	GetOrganizations(ctx context.Context, limit, offset int) ([]model.Organization, error)
+	GetOrganization(ctx context.Context, organizationId uuid.UUID) (model.Organization, error)
+	UpdateOrganization(ctx context.Context, organization model.Organization, username string) (model.Organization, error)
+	DeleteOrganization(ctx context.Context, organizationId uuid.UUID, username string) error
+	GetResponsibles(
+		ctx context.Context,
+		organizationId uuid.UUID,
+		limit, offset int,
+	) ([]model.OrganizationResponsible, error)
+	AddResponsible(
+		ctx context.Context,
+		organizationId uuid.UUID,
+		employeeUsername string,
+		role model.OrganizationRole,
+		username string,
+	) error
+	UpdateResponsibleRole(
+		ctx context.Context,
+		organizationId uuid.UUID,
+		employeeUsername string,
+		role model.OrganizationRole,
+		username string,
+	) error
+	RemoveResponsible(ctx context.Context, organizationId uuid.UUID, employeeUsername, username string) error
+}
+type IEmployee interface {
+	CreateEmployee(ctx context.Context, employee model.Employee, password string) (model.Employee, error)
+	GetEmployees(ctx context.Context, limit, offset int) ([]model.Employee, error)
+	GetEmployee(ctx context.Context, username string) (model.Employee, error)
+	UpdateEmployee(ctx context.Context, employee model.Employee, username string) (model.Employee, error)
+	DeleteEmployee(ctx context.Context, employeeUsername, username string) error
+	GetEmployeePermissions(
+		ctx context.Context,
+		employeeUsername, username string,
+	) ([]model.OrganizationPermissions, error)
+}
+type IAuth interface {
+	Login(ctx context.Context, username, password string) (string, time.Time, error)
+	Authenticate(ctx context.Context, token string) (model.Employee, error)
+}
+type ISearch interface {
+	SearchTenders(ctx context.Context, user string, search model.TenderSearch) ([]model.TenderSearchResult, error)
 }
 type Services struct {
 	ITender
 	IBids
+	IOrganization
+	IEmployee
+	IAuth
+	ISearch
 }
 type ServicesDeps struct {
-	Repository *repository.Repositories
+	Repository   *repository.Repositories
+	TokenManager *auth.TokenManager
 }
 
 func NewServices(deps ServicesDeps) *Services {
-	return &Services{NewTenderService(deps.Repository), NewBidsService(deps.Repository)}
+	accessPolicy := policy.New(deps.Repository, deps.Repository)
+	return &Services{
+		NewTenderService(deps.Repository, deps.Repository, deps.Repository, accessPolicy),
+		NewBidsService(deps.Repository, deps.Repository, deps.Repository, deps.Repository, accessPolicy),
+		NewOrganizationService(deps.Repository, accessPolicy),
+		NewEmployeeService(deps.Repository, accessPolicy),
+		NewAuthService(deps.Repository, deps.TokenManager),
+		NewSearchService(deps.Repository, accessPolicy),
+	}
 }
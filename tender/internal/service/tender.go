@@ -2,72 +2,334 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"zadanie-6105/helper"
+	"time"
 	custom_errors "zadanie-6105/internal/custom-errors"
 	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/policy"
 	"zadanie-6105/internal/repository"
+	"zadanie-6105/internal/statemachine"
 
 	"github.com/google/uuid"
 )
 
+// expiredBatch — сколько просроченных тендеров CloseExpired закрывает за один вызов.
+const expiredBatch = 100
+
 type TenderService struct {
-	tenderRepository repository.ITender
+	tenderRepository      repository.ITender
+	publicationRepository repository.IPublication
+	transactor            repository.Transactor
+	policy                *policy.Policy
+	machine               *statemachine.Machine
 }
 
-func NewTenderService(tenderRepository repository.ITender) *TenderService {
+func NewTenderService(
+	tenderRepository repository.ITender,
+	publicationRepository repository.IPublication,
+	transactor repository.Transactor,
+	accessPolicy *policy.Policy,
+) *TenderService {
 	return &TenderService{
-		tenderRepository: tenderRepository,
+		tenderRepository:      tenderRepository,
+		publicationRepository: publicationRepository,
+		transactor:            transactor,
+		policy:                accessPolicy,
+		machine:               newTenderMachine(tenderRepository),
 	}
 }
 
 func (tS *TenderService) GetTenders(
 	ctx context.Context,
-	limit int,
-	offset int,
-	serviceTypesArr []string,
-) ([]model.Tender, error) {
-	return tS.tenderRepository.GetTenders(ctx, limit, offset, serviceTypesArr)
+	user string,
+	page model.Page,
+	filter model.TenderFilter,
+) (model.List[model.Tender], error) {
+	// Анонимный пользователь видит только опубликованные тендеры
+	if user != "" {
+		err := tS.policy.Authenticate(ctx, user)
+		if err != nil {
+			return model.List[model.Tender]{}, err
+		}
+	}
+	return tS.tenderRepository.GetTenders(ctx, user, page, filter)
 }
 
 func (tS *TenderService) CreateTender(ctx context.Context, tender model.Tender) (model.Tender, error) {
-	path := "service.tender.CreateTender"
-	isResponsible, err := tS.tenderRepository.IsUserResponsibleForOrganization(ctx, tender)
+	err := tS.policy.Authorize(ctx, tender.CreatorUsername, tender.OrganizationID, model.PermissionTenderManage)
 	if err != nil {
-		return model.Tender{}, fmt.Errorf(path+".IsUserResponsibleForOrganization, error: {%s}",
-			custom_errors.ErrAccessDenied.Error())
+		return model.Tender{}, err
 	}
-	if !isResponsible {
-		return model.Tender{}, fmt.Errorf("пользователь не связан с организацией")
+	if tender.DeadlinePassed(time.Now()) {
+		return model.Tender{}, custom_errors.ErrInvalidDeadline
+	}
+	if tender.PublishAt != nil {
+		err = validPublishAt(tender, *tender.PublishAt, time.Now())
+		if err != nil {
+			return model.Tender{}, err
+		}
 	}
-	return tS.tenderRepository.CreateTender(ctx, tender)
+	tender.Status = model.TenderStatusCreated
+	return inTx(ctx, tS.transactor, func(ctx context.Context) (model.Tender, error) {
+		path := "service.tender.CreateTender"
+		res, err := tS.tenderRepository.CreateTender(ctx, tender)
+		if err != nil || tender.PublishAt == nil {
+			return res, err
+		}
+		_, err = tS.publicationRepository.SavePublication(ctx, model.TenderPublication{
+			TenderID:  res.ID,
+			PublishAt: *tender.PublishAt,
+			CreatedBy: tender.CreatorUsername,
+		})
+		if err != nil {
+			return model.Tender{}, fmt.Errorf(path+".SavePublication, error: {%w}", err)
+		}
+		res.PublishAt = tender.PublishAt
+		return res, nil
+	})
 }
 
-func (tS *TenderService) GetTender(ctx context.Context, user string, limit int, offset int) ([]model.Tender, error) {
-	return tS.tenderRepository.GetTender(ctx, user, limit, offset)
+func (tS *TenderService) GetTender(
+	ctx context.Context,
+	user string,
+	page model.Page,
+) (model.List[model.Tender], error) {
+	err := tS.policy.Authenticate(ctx, user)
+	if err != nil {
+		return model.List[model.Tender]{}, err
+	}
+	return tS.tenderRepository.GetTender(ctx, user, page)
 }
 
+// UpdateTender применяет правку. Ненулевой tender.Version — версия, которую видел клиент (If-Match):
+// если тендер успели изменить, возвращается его актуальное состояние и ErrVersionConflict.
 func (tS *TenderService) UpdateTender(ctx context.Context, tender model.Tender) (model.Tender, error) {
-	if tender.Status != "" {
-		flag := helper.IsValidTenderStatus(tender.Status)
-		if !flag {
+	return inTx(ctx, tS.transactor, func(ctx context.Context) (model.Tender, error) {
+		path := "service.tender.UpdateTender"
+		current, err := tS.authorizeTender(ctx, tender.ID, tender.CreatorUsername)
+		if err != nil {
+			return model.Tender{}, err
+		}
+		if staleVersion(current.Version, tender.Version) {
+			return current, custom_errors.ErrVersionConflict
+		}
+		if tender.DeadlinePassed(time.Now()) {
+			return model.Tender{}, custom_errors.ErrInvalidDeadline
+		}
+		if tender.Status != "" && current.Status != tender.Status {
+			err = tS.checkTransition(ctx, current, tender.Status, tender.CreatorUsername)
+			if err != nil {
+				return model.Tender{}, err
+			}
+			tender.ClosedBy = closedBy(tender.Status, tender.CreatorUsername)
+		} else {
+			tender.ClosedBy = current.ClosedBy
+		}
+
+		res, err := tS.tenderRepository.UpdateTender(ctx, tender)
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return tS.versionConflict(ctx, tender.ID)
+		}
+		if err != nil {
+			return model.Tender{}, err
+		}
+		if tender.Status != "" {
+			err = tS.machine.Apply(ctx, res.Status, res.ID)
+			if err != nil {
+				return model.Tender{}, fmt.Errorf(path+".Apply, error: {%w}", err)
+			}
+			err = tS.dropPublication(ctx, current, res)
+			if err != nil {
+				return model.Tender{}, fmt.Errorf(path+".dropPublication, error: {%w}", err)
+			}
+		}
+		return res, nil
+	})
+}
+
+func (tS *TenderService) GetStatus(ctx context.Context, tenderId uuid.UUID, user string) (string, error) {
+	if user != "" {
+		err := tS.policy.Authenticate(ctx, user)
+		if err != nil {
+			return "", err
+		}
+	}
+	return tS.tenderRepository.GetStatus(ctx, tenderId, user)
+}
+
+// UpdateStatus меняет статус тендера; tender.Version проверяется так же, как в UpdateTender.
+func (tS *TenderService) UpdateStatus(ctx context.Context, tender model.Tender) (model.Tender, error) {
+	return inTx(ctx, tS.transactor, func(ctx context.Context) (model.Tender, error) {
+		path := "service.tender.UpdateStatus"
+		if !tS.machine.HasState(tender.Status) {
 			return model.Tender{}, custom_errors.ErrUnprocessableEntity
 		}
+		current, err := tS.authorizeTender(ctx, tender.ID, tender.CreatorUsername)
+		if err != nil {
+			return model.Tender{}, err
+		}
+		if staleVersion(current.Version, tender.Version) {
+			return current, custom_errors.ErrVersionConflict
+		}
+		err = tS.checkTransition(ctx, current, tender.Status, tender.CreatorUsername)
+		if err != nil {
+			return model.Tender{}, err
+		}
+		// Опубликованный после срока тендер планировщик сразу бы закрыл
+		if tender.Status == model.TenderStatusPublished && current.DeadlinePassed(time.Now()) {
+			return model.Tender{}, custom_errors.ErrSubmissionDeadlinePassed
+		}
+		tender.ClosedBy = closedBy(tender.Status, tender.CreatorUsername)
+
+		res, err := tS.tenderRepository.UpdateStatus(ctx, tender)
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return tS.versionConflict(ctx, tender.ID)
+		}
+		if err != nil {
+			return model.Tender{}, err
+		}
+		err = tS.machine.Apply(ctx, res.Status, res.ID)
+		if err != nil {
+			return model.Tender{}, fmt.Errorf(path+".Apply, error: {%w}", err)
+		}
+		err = tS.dropPublication(ctx, current, res)
+		if err != nil {
+			return model.Tender{}, fmt.Errorf(path+".dropPublication, error: {%w}", err)
+		}
+		return res, nil
+	})
+}
+
+// closedBy возвращает, кого записать закрывшим тендер: пользователя, если тендер переходит в Closed.
+func closedBy(status, username string) string {
+	if status == model.TenderStatusClosed {
+		return username
 	}
+	return ""
+}
 
-	return tS.tenderRepository.UpdateTender(ctx, tender)
+// CloseExpired закрывает опубликованные тендеры, срок подачи предложений которых истек к моменту now.
+// Закрывает сам сервис по правилам перехода для statemachine.RoleSystem, в ClosedBy записывается
+// model.ClosedBySystem. Тендеры обрабатываются по одному, чтобы ошибка в одном не откатывала остальные;
+// за вызов закрывается не больше expiredBatch тендеров. Возвращает число закрытых.
+func (tS *TenderService) CloseExpired(ctx context.Context, now time.Time) (int, error) {
+	path := "service.tender.CloseExpired"
+	ids, err := tS.tenderRepository.GetExpiredTenders(ctx, now, expiredBatch)
+	if err != nil {
+		return 0, fmt.Errorf(path+".GetExpiredTenders, error: {%w}", err)
+	}
+	var closed int
+	var errs []error
+	for _, id := range ids {
+		ok, err := tS.closeExpired(ctx, id, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(path+".closeExpired %s, error: {%w}", id, err))
+			continue
+		}
+		if ok {
+			closed++
+		}
+	}
+	return closed, errors.Join(errs...)
 }
 
-func (tS *TenderService) GetStatus(ctx context.Context, tenderId uuid.UUID) (string, error) {
-	return tS.tenderRepository.GetStatus(ctx, tenderId)
+func (tS *TenderService) closeExpired(ctx context.Context, tenderId uuid.UUID, now time.Time) (bool, error) {
+	return inTx(ctx, tS.transactor, func(ctx context.Context) (bool, error) {
+		err := tS.tenderRepository.LockTender(ctx, tenderId)
+		if err != nil {
+			return false, err
+		}
+		current, err := tS.tenderRepository.GetTenderById(ctx, tenderId)
+		if err != nil {
+			return false, err
+		}
+		// Пока шел обход, тендер могли закрыть вручную или продлить срок
+		if current.Status != model.TenderStatusPublished || !current.DeadlinePassed(now) {
+			return false, nil
+		}
+		err = tS.machine.Check(current.Status, model.TenderStatusClosed, statemachine.RoleSystem)
+		if err != nil {
+			return false, err
+		}
+		res, err := tS.tenderRepository.UpdateStatus(ctx, model.Tender{
+			ID:       tenderId,
+			Status:   model.TenderStatusClosed,
+			Version:  current.Version,
+			ClosedBy: model.ClosedBySystem,
+		})
+		if err != nil {
+			return false, err
+		}
+		return true, tS.machine.Apply(ctx, res.Status, res.ID)
+	})
 }
 
-func (tS *TenderService) UpdateStatus(ctx context.Context, tender model.Tender) (model.Tender, error) {
-	if tender.Status != "" {
-		flag := helper.IsValidTenderStatus(tender.Status)
-		if !flag {
+// checkTransition вызывается после authorizeTender, поэтому у пользователя уже есть право управлять тендером.
+func (tS *TenderService) checkTransition(ctx context.Context, current model.Tender, status, username string) error {
+	roles := []statemachine.Role{statemachine.RoleResponsible}
+	if current.CreatorUsername == username {
+		roles = append(roles, statemachine.RoleAuthor)
+	}
+	return tS.machine.Check(current.Status, status, roles...)
+}
+
+// RollbackTender откатывает параметры тендера к версии version; tender.Version — ожидаемая текущая версия.
+func (tS *TenderService) RollbackTender(ctx context.Context, tender model.Tender, version int) (model.Tender, error) {
+	return inTx(ctx, tS.transactor, func(ctx context.Context) (model.Tender, error) {
+		if version < 1 {
 			return model.Tender{}, custom_errors.ErrUnprocessableEntity
 		}
+		current, err := tS.authorizeTender(ctx, tender.ID, tender.CreatorUsername)
+		if err != nil {
+			return model.Tender{}, err
+		}
+		if staleVersion(current.Version, tender.Version) {
+			return current, custom_errors.ErrVersionConflict
+		}
+		res, err := tS.tenderRepository.RollbackTender(ctx, tender.ID, version, tender.Version)
+		if errors.Is(err, custom_errors.ErrVersionConflict) {
+			return tS.versionConflict(ctx, tender.ID)
+		}
+		return res, err
+	})
+}
+
+// versionConflict возвращает актуальное состояние тендера вместе с ErrVersionConflict,
+// чтобы клиент мог показать пользователю свежие данные.
+func (tS *TenderService) versionConflict(ctx context.Context, tenderId uuid.UUID) (model.Tender, error) {
+	path := "service.tender.versionConflict"
+	current, err := tS.tenderRepository.GetTenderById(ctx, tenderId)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".GetTenderById, error: {%w}", err)
+	}
+	return current, custom_errors.ErrVersionConflict
+}
+
+// staleVersion сообщает, что клиент прислал устаревшую версию. Нулевая ожидаемая версия не проверяется.
+func staleVersion(current, expected int) bool {
+	return expected != 0 && current != expected
+}
+
+// authorizeTender блокирует и загружает тендер и проверяет право пользователя управлять тендерами его организации.
+// Вызывается внутри inTx: блокировка держится до конца единицы работы.
+func (tS *TenderService) authorizeTender(
+	ctx context.Context,
+	tenderId uuid.UUID,
+	username string,
+) (model.Tender, error) {
+	path := "service.tender.authorizeTender"
+	err := tS.tenderRepository.LockTender(ctx, tenderId)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".LockTender, error: {%w}", err)
+	}
+	current, err := tS.tenderRepository.GetTenderById(ctx, tenderId)
+	if err != nil {
+		return model.Tender{}, fmt.Errorf(path+".GetTenderById, error: {%w}", err)
+	}
+	err = tS.policy.Authorize(ctx, username, current.OrganizationID, model.PermissionTenderManage)
+	if err != nil {
+		return model.Tender{}, err
 	}
-	return tS.tenderRepository.UpdateStatus(ctx, tender)
+	return current, nil
 }
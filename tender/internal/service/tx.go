@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+	"zadanie-6105/internal/repository"
+)
+
+// inTx выполняет fn как единицу работы: все вызовы репозиториев внутри fn видят одну транзакцию,
+// а ошибка откатывает их вместе. Результат fn возвращается и при ошибке — например, актуальное
+// состояние объекта вместе с ErrVersionConflict.
+func inTx[T any](
+	ctx context.Context,
+	transactor repository.Transactor,
+	fn func(ctx context.Context) (T, error),
+) (T, error) {
+	var res T
+	err := transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = fn(ctx)
+		return err
+	})
+	return res, err
+}
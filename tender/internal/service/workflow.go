@@ -0,0 +1,37 @@
+package service
+
+import (
+	"zadanie-6105/internal/model"
+	"zadanie-6105/internal/repository"
+	"zadanie-6105/internal/statemachine"
+)
+
+func newTenderMachine(tenderRepository repository.ITender) *statemachine.Machine {
+	return statemachine.New("tender",
+		model.TenderStatusCreated,
+		model.TenderStatusPublished,
+		model.TenderStatusClosed,
+	).
+		Allow(model.TenderStatusCreated, model.TenderStatusPublished,
+			statemachine.RoleAuthor, statemachine.RoleResponsible).
+		Allow(model.TenderStatusCreated, model.TenderStatusClosed,
+			statemachine.RoleAuthor, statemachine.RoleResponsible).
+		Allow(model.TenderStatusPublished, model.TenderStatusClosed,
+			statemachine.RoleAuthor, statemachine.RoleResponsible, statemachine.RoleSystem).
+		// У закрытого тендера не остается предложений в ожидании решения
+		OnEnter(model.TenderStatusClosed, tenderRepository.SettleTenderBids)
+}
+
+func newBidsMachine() *statemachine.Machine {
+	return statemachine.New("bid",
+		model.BidsStatusCreated,
+		model.BidsStatusPublished,
+		model.BidsStatusCanceled,
+	).
+		Allow(model.BidsStatusCreated, model.BidsStatusPublished,
+			statemachine.RoleAuthor, statemachine.RoleResponsible).
+		Allow(model.BidsStatusCreated, model.BidsStatusCanceled,
+			statemachine.RoleAuthor, statemachine.RoleResponsible).
+		Allow(model.BidsStatusPublished, model.BidsStatusCanceled,
+			statemachine.RoleAuthor, statemachine.RoleResponsible)
+}
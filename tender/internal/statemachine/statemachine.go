@@ -0,0 +1,108 @@
+package statemachine
+
+import (
+	"context"
+	"fmt"
+	custom_errors "zadanie-6105/internal/custom-errors"
+
+	"github.com/google/uuid"
+)
+
+type Role string
+
+const (
+	// RoleAuthor — пользователь, создавший сущность
+	RoleAuthor Role = "author"
+	// RoleResponsible — участник организации, чья роль позволяет управлять сущностью
+	RoleResponsible Role = "responsible"
+	// RoleSystem — действия, которые сервис выполняет сам, без пользователя
+	RoleSystem Role = "system"
+)
+
+type Effect func(ctx context.Context, id uuid.UUID) error
+
+type transition struct {
+	roles map[Role]struct{}
+}
+
+// Machine описывает граф допустимых переходов между статусами,
+// роли, которым разрешен каждый переход, и побочные эффекты при входе в статус.
+type Machine struct {
+	name        string
+	states      map[string]struct{}
+	transitions map[string]map[string]transition
+	effects     map[string][]Effect
+}
+
+func New(name string, states ...string) *Machine {
+	m := &Machine{
+		name:        name,
+		states:      make(map[string]struct{}, len(states)),
+		transitions: make(map[string]map[string]transition),
+		effects:     make(map[string][]Effect),
+	}
+	for _, state := range states {
+		m.states[state] = struct{}{}
+	}
+	return m
+}
+
+func (m *Machine) Allow(from, to string, roles ...Role) *Machine {
+	m.mustHaveState(from)
+	m.mustHaveState(to)
+
+	t := transition{roles: make(map[Role]struct{}, len(roles))}
+	for _, role := range roles {
+		t.roles[role] = struct{}{}
+	}
+	if m.transitions[from] == nil {
+		m.transitions[from] = make(map[string]transition)
+	}
+	m.transitions[from][to] = t
+	return m
+}
+
+func (m *Machine) OnEnter(state string, effects ...Effect) *Machine {
+	m.mustHaveState(state)
+	m.effects[state] = append(m.effects[state], effects...)
+	return m
+}
+
+func (m *Machine) HasState(state string) bool {
+	_, ok := m.states[state]
+	return ok
+}
+
+// Check проверяет, что переход from -> to объявлен и хотя бы одна из ролей пользователя может его выполнить.
+func (m *Machine) Check(from, to string, roles ...Role) error {
+	if !m.HasState(to) {
+		return custom_errors.ErrUnprocessableEntity
+	}
+	t, ok := m.transitions[from][to]
+	if !ok {
+		return fmt.Errorf("%s: %s -> %s: %w", m.name, from, to, custom_errors.ErrInvalidStatusTransition)
+	}
+	for _, role := range roles {
+		if _, ok := t.roles[role]; ok {
+			return nil
+		}
+	}
+	return custom_errors.ErrAccessDenied
+}
+
+// Apply выполняет побочные эффекты входа в статус state.
+func (m *Machine) Apply(ctx context.Context, state string, id uuid.UUID) error {
+	for _, effect := range m.effects[state] {
+		err := effect(ctx, id)
+		if err != nil {
+			return fmt.Errorf("%s: enter %s: %w", m.name, state, err)
+		}
+	}
+	return nil
+}
+
+func (m *Machine) mustHaveState(state string) {
+	if !m.HasState(state) {
+		panic(fmt.Sprintf("statemachine %s: unknown state %q", m.name, state))
+	}
+}
@@ -0,0 +1,188 @@
+// Package validation проверяет запросы по ограничениям openapi.yml: типы, форматы, перечисления,
+// длины строк и диапазоны чисел. Ошибки собираются по всем полям сразу, чтобы клиент
+// исправил запрос за один раз.
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/google/uuid"
+)
+
+func init() {
+	// kin-openapi не проверяет format: uuid без явного определения; сгенерированный роутер разбирает
+	// идентификаторы через uuid.Parse, поэтому и проверка использует его
+	openapi3.DefineStringFormatValidator("uuid", openapi3.NewCallbackValidator(func(value string) error {
+		_, err := uuid.Parse(value)
+		return err
+	}))
+}
+
+// FieldError — нарушение ограничения спецификации в одном поле запроса. Rule — нарушенное ограничение
+// схемы (maxLength, enum, ...), Args — его параметры; по ним контроллер переводит Message.
+type FieldError struct {
+	Field   string
+	Message string
+	Rule    string
+	Args    []any
+}
+
+// Error возвращается, если запрос не соответствует спецификации. Fields перечисляет все нарушения.
+type Error struct {
+	Fields []FieldError
+}
+
+func (e *Error) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		messages = append(messages, f.Field+": "+f.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+type Validator struct {
+	options *openapi3filter.Options
+}
+
+func New() *Validator {
+	return &Validator{
+		options: &openapi3filter.Options{
+			MultiError: true,
+			// Аутентификацию выполняет middleware контроллера, здесь проверяется только формат запроса
+			AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+			SkipSettingDefaults: true,
+		},
+	}
+}
+
+// Request проверяет запрос по операции route. pathParams — значения параметров пути,
+// уже выделенные роутером. Нарушения спецификации возвращаются как *Error.
+func (v *Validator) Request(
+	ctx context.Context,
+	route *routers.Route,
+	req *http.Request,
+	pathParams map[string]string,
+) error {
+	path := "internal.validation.Request"
+
+	err := openapi3filter.ValidateRequest(ctx, &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    v.options,
+	})
+	if err == nil {
+		return nil
+	}
+	fields := fieldErrors(err)
+	if len(fields) == 0 {
+		return fmt.Errorf(path+".ValidateRequest, error: {%s}", err.Error())
+	}
+	return &Error{Fields: fields}
+}
+
+// fieldErrors разбирает ошибки по типу без errors.As: RequestError разворачивается во вложенный
+// MultiError ошибок схемы, и errors.As спутал бы его с MultiError верхнего уровня.
+func fieldErrors(err error) []FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var fields []FieldError
+		for _, item := range e {
+			fields = append(fields, fieldErrors(item)...)
+		}
+		return fields
+	case *openapi3filter.RequestError:
+		field := "body"
+		if e.Parameter != nil {
+			field = e.Parameter.Name
+		}
+		if e.Err == nil {
+			return []FieldError{{Field: field, Message: e.Reason}}
+		}
+		return schemaErrors(field, e.Parameter == nil, e.Err)
+	}
+	return nil
+}
+
+// schemaErrors раскладывает ошибки схемы по полям. Для тела запроса поле — путь до значения
+// через точку, для параметра — имя параметра.
+func schemaErrors(field string, inBody bool, err error) []FieldError {
+	var multi openapi3.MultiError
+	if errors.As(err, &multi) {
+		var fields []FieldError
+		for _, e := range multi {
+			fields = append(fields, schemaErrors(field, inBody, e)...)
+		}
+		return fields
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		if pointer := schemaErr.JSONPointer(); inBody && len(pointer) > 0 {
+			field = strings.Join(pointer, ".")
+		}
+		rule, args := schemaRule(schemaErr)
+		return []FieldError{{Field: field, Message: schemaErr.Reason, Rule: rule, Args: args}}
+	}
+	if errors.Is(err, openapi3filter.ErrInvalidRequired) {
+		return []FieldError{{Field: field, Message: "value is required", Rule: "required"}}
+	}
+	var parseErr *openapi3filter.ParseError
+	if errors.As(err, &parseErr) {
+		return []FieldError{{Field: field, Message: parseErr.Reason}}
+	}
+	return []FieldError{{Field: field, Message: err.Error()}}
+}
+
+// schemaRule возвращает нарушенное ограничение и его параметры. Для ограничений, которых нет
+// в openapi.yml, возвращается пустое правило, и клиент получит исходное сообщение kin-openapi.
+func schemaRule(e *openapi3.SchemaError) (string, []any) {
+	schema := e.Schema
+	if schema == nil {
+		return "", nil
+	}
+	switch e.SchemaField {
+	case "required":
+		return e.SchemaField, nil
+	case "type":
+		return e.SchemaField, []any{strings.Join(schema.Type.Slice(), ", ")}
+	case "format":
+		return e.SchemaField, []any{schema.Format}
+	case "pattern":
+		return e.SchemaField, []any{schema.Pattern}
+	case "enum":
+		values := make([]string, 0, len(schema.Enum))
+		for _, value := range schema.Enum {
+			values = append(values, fmt.Sprint(value))
+		}
+		return e.SchemaField, []any{strings.Join(values, ", ")}
+	case "minLength":
+		return e.SchemaField, []any{schema.MinLength}
+	case "minItems":
+		return e.SchemaField, []any{schema.MinItems}
+	case "maxLength":
+		if schema.MaxLength != nil {
+			return e.SchemaField, []any{*schema.MaxLength}
+		}
+	case "maxItems":
+		if schema.MaxItems != nil {
+			return e.SchemaField, []any{*schema.MaxItems}
+		}
+	case "minimum":
+		if schema.Min != nil {
+			return e.SchemaField, []any{*schema.Min}
+		}
+	case "maximum":
+		if schema.Max != nil {
+			return e.SchemaField, []any{*schema.Max}
+		}
+	}
+	return "", nil
+}
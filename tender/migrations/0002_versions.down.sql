@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS bids_version;
+DROP TABLE IF EXISTS tender_version;
@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS tender_version (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tender_id UUID REFERENCES tender(id) ON DELETE CASCADE,
+    version INT NOT NULL,
+    organization_id UUID,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    service_type VARCHAR(100),
+    status VARCHAR(20) NOT NULL,
+    creator_username VARCHAR(50),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (tender_id, version)
+);
+
+INSERT INTO tender_version (tender_id, version, organization_id, title, description, service_type, status,
+                            creator_username)
+SELECT id, version, organization_id, title, description, service_type, status, creator_username
+FROM tender
+ON CONFLICT (tender_id, version) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS bids_version (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    bid_id UUID REFERENCES bids(id) ON DELETE CASCADE,
+    version INT NOT NULL,
+    tender_id UUID,
+    organization_id UUID,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    status VARCHAR(20) NOT NULL,
+    creator_username VARCHAR(50),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (bid_id, version)
+);
+
+INSERT INTO bids_version (bid_id, version, tender_id, organization_id, title, description, status, creator_username)
+SELECT id, version, tender_id, organization_id, title, description, status, creator_username
+FROM bids
+ON CONFLICT (bid_id, version) DO NOTHING;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS bid_feedback (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    bid_id UUID REFERENCES bids(id) ON DELETE CASCADE,
+    description VARCHAR(1000) NOT NULL,
+    author_username VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
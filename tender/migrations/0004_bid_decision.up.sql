@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS bid_decision (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    bid_id UUID REFERENCES bids(id) ON DELETE CASCADE,
+    username VARCHAR(50) NOT NULL,
+    decision VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (bid_id, username)
+);
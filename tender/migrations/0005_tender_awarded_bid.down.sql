@@ -0,0 +1 @@
+ALTER TABLE tender DROP COLUMN IF EXISTS awarded_bid_id;
@@ -0,0 +1,2 @@
+ALTER TABLE tender
+    ADD COLUMN IF NOT EXISTS awarded_bid_id UUID REFERENCES bids(id) ON DELETE SET NULL;
@@ -0,0 +1 @@
+ALTER TABLE organization_responsible DROP COLUMN IF EXISTS role;
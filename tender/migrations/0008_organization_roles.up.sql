@@ -0,0 +1,4 @@
+-- Прежние ответственные получают полные права владельца
+ALTER TABLE organization_responsible
+    ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'owner'
+        CHECK (role IN ('owner', 'tender_manager', 'bid_author', 'reviewer', 'viewer'));
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS bids_tender_created_at_id_idx;
+DROP INDEX IF EXISTS bids_creator_created_at_id_idx;
+DROP INDEX IF EXISTS tender_creator_created_at_id_idx;
+DROP INDEX IF EXISTS tender_created_at_id_idx;
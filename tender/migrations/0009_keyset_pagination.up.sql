@@ -0,0 +1,5 @@
+-- Списки листаются курсором по (created_at, id) от новых к старым
+CREATE INDEX IF NOT EXISTS tender_created_at_id_idx ON tender (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS tender_creator_created_at_id_idx ON tender (creator_username, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS bids_creator_created_at_id_idx ON bids (creator_username, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS bids_tender_created_at_id_idx ON bids (tender_id, created_at DESC, id DESC);
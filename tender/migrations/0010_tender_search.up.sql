@@ -0,0 +1,12 @@
+-- Полнотекстовый поиск по названию и описанию тендера. Русская конфигурация приводит к основе русские слова
+-- (латиницу — английским стеммером), английская дополнительно учитывает английские стоп-слова.
+-- Название весит больше описания.
+ALTER TABLE tender
+    ADD COLUMN IF NOT EXISTS search tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS tender_search_idx ON tender USING GIN (search);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS tender_published_deadline_idx;
+ALTER TABLE tender
+    DROP COLUMN IF EXISTS closed_by,
+    DROP COLUMN IF EXISTS submission_deadline;
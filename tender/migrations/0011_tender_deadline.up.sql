@@ -0,0 +1,7 @@
+-- Срок подачи предложений. После него предложения не принимаются, а опубликованный тендер закрывается
+-- планировщиком. closed_by — кто закрыл тендер: имя пользователя или system.
+ALTER TABLE tender
+    ADD COLUMN IF NOT EXISTS submission_deadline TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS closed_by VARCHAR(50) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS tender_published_deadline_idx ON tender (submission_deadline) WHERE status = 'Published';
@@ -0,0 +1,14 @@
+-- Отложенная публикация тендеров. На тендер приходится одна строка: пока status = 'Pending', ее можно
+-- перенести или отменить, а после срабатывания в status остается итог, в error — код ошибки.
+-- Публикация выполняется от имени created_by.
+CREATE TABLE IF NOT EXISTS tender_publication (
+    tender_id UUID PRIMARY KEY REFERENCES tender(id) ON DELETE CASCADE,
+    publish_at TIMESTAMPTZ NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    created_by VARCHAR(50) NOT NULL,
+    error VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS tender_publication_pending_idx ON tender_publication (publish_at) WHERE status = 'Pending';
@@ -0,0 +1,10 @@
+// Package migrations содержит SQL-миграции схемы, встроенные в бинарник.
+// Файлы называются NNNN_name.up.sql и NNNN_name.down.sql; номер задает порядок применения.
+// Миграции пишутся условно (IF NOT EXISTS, ON CONFLICT DO NOTHING): в базе, развернутой вручную,
+// часть объектов уже может быть, и migrate up не должен на них падать.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
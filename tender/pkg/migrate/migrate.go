@@ -0,0 +1,232 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+	"zadanie-6105/pkg/postgres"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// lockKey — ключ advisory-блокировки, чтобы несколько экземпляров сервиса не применяли миграции одновременно.
+const lockKey = 6105
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	pool       postgres.PgxPool
+	migrations []Migration
+}
+
+// New читает пары up/down из fsys и упорядочивает их по номеру.
+func New(pool postgres.PgxPool, fsys fs.FS) (*Migrator, error) {
+	path := "pkg.migrate.New"
+
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf(path+".ReadDir, error: {%s}", err.Error())
+	}
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, _ := strconv.Atoi(match[1])
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf(path+".ReadFile, error: {%s}", err.Error())
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("%s: migration %d has different names: %s and %s", path, version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("%s: migration %d_%s must have both up and down files", path, m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return &Migrator{pool: pool, migrations: migrations}, nil
+}
+
+// Up применяет все непримененные миграции по порядку и возвращает примененные.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *pgx.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+			err = run(ctx, conn, migration, migration.Up,
+				`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`,
+				migration.Version, migration.Name)
+			if err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down откатывает steps последних примененных миграций и возвращает откаченные.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *pgx.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+			err = run(ctx, conn, migration, migration.Down,
+				`DELETE FROM schema_migrations WHERE version = $1`,
+				migration.Version)
+			if err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status возвращает все известные миграции с временем применения; у непримененных оно пустое.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var res []Status
+	err := m.withLock(ctx, func(conn *pgx.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			s := Status{Migration: migration}
+			if appliedAt, ok := done[migration.Version]; ok {
+				s.AppliedAt = &appliedAt
+			}
+			res = append(res, s)
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgx.Conn) error) error {
+	path := "pkg.migrate.withLock"
+
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Acquire, error: {%s}", err.Error())
+	}
+	defer conn.Release()
+
+	_, err = conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, lockKey)
+	if err != nil {
+		return fmt.Errorf(path+".Lock, error: {%s}", err.Error())
+	}
+	defer func() {
+		// Контекст запроса может быть уже отменен, а блокировку нужно снять в любом случае
+		_, _ = conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, lockKey)
+	}()
+
+	_, err = conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+	    version BIGINT PRIMARY KEY,
+	    name VARCHAR(255) NOT NULL,
+	    applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		return fmt.Errorf(path+".CreateTable, error: {%s}", err.Error())
+	}
+	return fn(conn.Conn())
+}
+
+func appliedVersions(ctx context.Context, conn *pgx.Conn) (map[int]time.Time, error) {
+	path := "pkg.migrate.appliedVersions"
+
+	rows, err := conn.Query(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf(path+".Query, error: {%s}", err.Error())
+	}
+	defer rows.Close()
+
+	res := make(map[int]time.Time)
+	for rows.Next() {
+		var (
+			version   int
+			appliedAt time.Time
+		)
+		err = rows.Scan(&version, &appliedAt)
+		if err != nil {
+			return nil, fmt.Errorf(path+".Scan, error: {%s}", err.Error())
+		}
+		res[version] = appliedAt
+	}
+	return res, rows.Err()
+}
+
+// run выполняет тело миграции и обновляет schema_migrations в одной транзакции.
+func run(ctx context.Context, conn *pgx.Conn, migration Migration, body, bookkeeping string, args ...any) error {
+	path := "pkg.migrate.run"
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, body)
+	if err != nil {
+		return fmt.Errorf(path+".Exec(%d_%s), error: {%s}", migration.Version, migration.Name, err.Error())
+	}
+	_, err = tx.Exec(ctx, bookkeeping, args...)
+	if err != nil {
+		return fmt.Errorf(path+".Bookkeeping, error: {%s}", err.Error())
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type txKey struct{}
+
+// Querier — методы, общие для пула соединений и транзакции.
+type Querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// WithinTx выполняет fn в транзакции, которая передается через контекст: репозитории,
+// получившие этот контекст, работают внутри нее. Вложенный вызов переиспользует внешнюю транзакцию.
+// Если fn вернула ошибку, транзакция откатывается.
+func (db *DB) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	path := "pkg.postgres.WithinTx"
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Begin, error: {%s}", err.Error())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		return err
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf(path+".Commit, error: {%s}", err.Error())
+	}
+	return nil
+}
+
+// Querier возвращает транзакцию из контекста, а вне WithinTx — пул соединений.
+func (db *DB) Querier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return db.Pool
+}
+
+// Begin начинает транзакцию репозитория. Внутри WithinTx это точка сохранения во внешней транзакции:
+// ее Commit не фиксирует изменения, пока не завершится вся единица работы.
+func (db *DB) Begin(ctx context.Context) (pgx.Tx, error) {
+	return db.Querier(ctx).Begin(ctx)
+}